@@ -34,6 +34,16 @@ type PhasePowers interface {
 	Powers() (float64, float64, float64, error)
 }
 
+// Frequency provides grid frequency in Hz
+type Frequency interface {
+	Frequency() (float64, error)
+}
+
+// Temperature provides ambient temperature in °C
+type Temperature interface {
+	Temperature() (float64, error)
+}
+
 // Battery provides battery Soc in %
 type Battery interface {
 	Soc() (float64, error)
@@ -150,6 +160,11 @@ type VehicleOdometer interface {
 	Odometer() (float64, error)
 }
 
+// OnBoardChargerLimit provides the vehicle's on-board charger max AC current
+type OnBoardChargerLimit interface {
+	OBCLimit() (float64, error)
+}
+
 // VehiclePosition returns the vehicles position in latitude and longitude
 type VehiclePosition interface {
 	Position() (float64, float64, error)
@@ -165,6 +180,12 @@ type SocLimiter interface {
 	GetLimitSoc() (int64, error)
 }
 
+// VehicleSocLimiter limits the vehicle soc using the charger
+type VehicleSocLimiter interface {
+	VehicleSocLimit() (int64, error)
+	SetVehicleSocLimit(int64) error
+}
+
 // ChargeController allows to start/stop the charging session on the vehicle side
 type ChargeController interface {
 	ChargeEnable(bool) error
@@ -175,6 +196,11 @@ type Resurrector interface {
 	WakeUp() error
 }
 
+// Waker wakes up a device that stopped communicating after an idle timeout
+type Waker interface {
+	Wakeup() error
+}
+
 // Tariff is a tariff capable of retrieving tariff rates
 type Tariff interface {
 	Rates() (Rates, error)
@@ -19,6 +19,10 @@ func ChargeModeString(mode string) (ChargeMode, error) {
 		return ModePV, nil
 	case string(ModeOff):
 		return ModeOff, nil
+	case string(ModeLazy):
+		return ModeLazy, nil
+	case string(ModeBoost):
+		return ModeBoost, nil
 	default:
 		return "", fmt.Errorf("invalid value: %s", mode)
 	}
@@ -5,7 +5,7 @@ import (
 	"strings"
 )
 
-// ChargeMode is the charge operation mode. Valid values are off, now, minpv and pv
+// ChargeMode is the charge operation mode. Valid values are off, now, minpv, pv, lazy and boost
 type ChargeMode string
 
 // Charge modes
@@ -15,6 +15,8 @@ const (
 	ModeNow   ChargeMode = "now"
 	ModeMinPV ChargeMode = "minpv"
 	ModePV    ChargeMode = "pv"
+	ModeLazy  ChargeMode = "lazy"
+	ModeBoost ChargeMode = "boost"
 )
 
 // String implements Stringer
@@ -0,0 +1,31 @@
+package api
+
+import "time"
+
+// ChargerEventType is the type of a charger state change
+type ChargerEventType string
+
+// Charger event types
+const (
+	ChargerPlugged   ChargerEventType = "plugged"
+	ChargerUnplugged ChargerEventType = "unplugged"
+	ChargerFaulted   ChargerEventType = "faulted"
+	ChargerRecovered ChargerEventType = "recovered"
+)
+
+// ChargerEvent is a charger state change
+type ChargerEvent struct {
+	Type      ChargerEventType
+	Timestamp time.Time
+}
+
+// EventSource provides charger state changes without waiting for the next poll cycle.
+// Events returns a nil channel if event detection is not enabled.
+type EventSource interface {
+	Events() <-chan ChargerEvent
+}
+
+// FaultReporter provides the charger's fault code
+type FaultReporter interface {
+	FaultCode() (string, error)
+}
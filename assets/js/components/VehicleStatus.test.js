@@ -112,6 +112,22 @@ describe("climating", () => {
   });
 });
 
+describe("temperature", () => {
+  test("show derating", () => {
+    expectStatus(
+      { connected: true, charging: true, temperatureDerating: 12 },
+      "temperatureDerating",
+      { derating: 12 }
+    );
+  });
+  test("show stop", () => {
+    expectStatus({ connected: true, temperatureDerating: 100 }, "temperatureStop");
+  });
+  test("no derating", () => {
+    expectStatus({ connected: true, charging: true, temperatureDerating: 0 }, "charging");
+  });
+});
+
 describe("timer", () => {
   test("show pv enable timer if not enabled yet and timer exists", () => {
     expectStatus(
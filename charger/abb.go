@@ -57,20 +57,35 @@ func init() {
 
 // NewABBFromConfig creates a ABB charger from generic config
 func NewABBFromConfig(other map[string]interface{}) (api.Charger, error) {
-	cc := modbus.Settings{
-		ID: 1,
+	cc := struct {
+		modbus.Settings `mapstructure:",squash"`
+		TLS             *modbus.TLSSettings
+	}{
+		Settings: modbus.Settings{
+			ID: 1,
+		},
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
 		return nil, err
 	}
 
-	return NewABB(cc.URI, cc.Device, cc.Comset, cc.Baudrate, modbus.ProtocolFromRTU(cc.RTU), cc.ID)
+	return NewABB(cc.URI, cc.Device, cc.Comset, cc.Baudrate, cc.Protocol(), cc.ID, cc.TLS)
 }
 
 // NewABB creates ABB charger
-func NewABB(uri, device, comset string, baudrate int, proto modbus.Protocol, slaveID uint8) (api.Charger, error) {
-	conn, err := modbus.NewConnection(uri, device, comset, baudrate, proto, slaveID)
+func NewABB(uri, device, comset string, baudrate int, proto modbus.Protocol, slaveID uint8, tls *modbus.TLSSettings) (api.Charger, error) {
+	var (
+		conn *modbus.Connection
+		err  error
+	)
+
+	// cloud gateways may require Modbus TCP over TLS
+	if tls != nil {
+		conn, err = modbus.NewTLSConnection(uri, slaveID, *tls)
+	} else {
+		conn, err = modbus.NewConnection(uri, device, comset, baudrate, proto, slaveID)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -89,8 +104,16 @@ func NewABB(uri, device, comset string, baudrate int, proto modbus.Protocol, sla
 
 	// keep-alive
 	go func() {
-		for range time.Tick(30 * time.Second) {
-			_, _ = wb.status()
+		tick := time.NewTicker(30 * time.Second)
+		defer tick.Stop()
+
+		for {
+			select {
+			case <-conn.Context().Done():
+				return
+			case <-tick.C:
+				_, _ = wb.status()
+			}
 		}
 	}()
 
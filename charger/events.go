@@ -0,0 +1,77 @@
+package charger
+
+import (
+	"context"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+)
+
+// statusEvents polls the charger status in the background and emits state change events until ctx is cancelled
+// A zero interval disables event detection and returns a nil channel.
+func statusEvents(ctx context.Context, log *util.Logger, status func() (api.ChargeStatus, error), interval time.Duration) <-chan api.ChargerEvent {
+	if interval <= 0 {
+		return nil
+	}
+
+	ch := make(chan api.ChargerEvent, 1)
+
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var prev api.ChargeStatus
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			s, err := status()
+			if err != nil {
+				continue
+			}
+
+			if typ, ok := statusEventType(prev, s); ok {
+				select {
+				case ch <- api.ChargerEvent{Type: typ, Timestamp: time.Now()}:
+				default:
+					log.DEBUG.Printf("event %s dropped", typ)
+				}
+			}
+
+			prev = s
+		}
+	}()
+
+	return ch
+}
+
+// statusEventType returns the event type for a charger status change
+func statusEventType(prev, status api.ChargeStatus) (api.ChargerEventType, bool) {
+	fault := func(s api.ChargeStatus) bool {
+		return s == api.StatusE || s == api.StatusF
+	}
+
+	switch {
+	case prev == api.StatusNone || prev == status:
+		return "", false
+	case fault(status) && fault(prev):
+		return "", false
+	case fault(status):
+		return api.ChargerFaulted, true
+	case fault(prev):
+		return api.ChargerRecovered, true
+	case prev == api.StatusA:
+		return api.ChargerPlugged, true
+	case status == api.StatusA:
+		return api.ChargerUnplugged, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,35 @@
+package charger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusEventType(t *testing.T) {
+	for _, tc := range []struct {
+		prev, status api.ChargeStatus
+		typ          api.ChargerEventType
+	}{
+		{api.StatusNone, api.StatusB, ""},
+		{api.StatusA, api.StatusA, ""},
+		{api.StatusA, api.StatusB, api.ChargerPlugged},
+		{api.StatusA, api.StatusC, api.ChargerPlugged},
+		{api.StatusB, api.StatusC, ""},
+		{api.StatusC, api.StatusA, api.ChargerUnplugged},
+		{api.StatusC, api.StatusF, api.ChargerFaulted},
+		{api.StatusE, api.StatusF, ""},
+		{api.StatusF, api.StatusB, api.ChargerRecovered},
+	} {
+		typ, ok := statusEventType(tc.prev, tc.status)
+		assert.Equal(t, tc.typ, typ, tc)
+		assert.Equal(t, tc.typ != "", ok, tc)
+	}
+}
+
+func TestStatusEventsDisabled(t *testing.T) {
+	assert.Nil(t, statusEvents(context.Background(), util.NewLogger("foo"), nil, 0))
+}
@@ -35,6 +35,7 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func TestGoEV1(t *testing.T) {
 	h := &handler{}
 	srv := httptest.NewServer(h)
+	defer srv.Close()
 
 	sponsor.Subject = "foo"
 
@@ -59,6 +60,7 @@ func TestGoEV1(t *testing.T) {
 func TestGoEV2(t *testing.T) {
 	h := &handler{}
 	srv := httptest.NewServer(h)
+	defer srv.Close()
 
 	sponsor.Subject = "foo"
 
@@ -7,6 +7,7 @@ import (
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/meter/homewizard"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
 )
 
 // HomeWizard project homepage
@@ -42,7 +43,7 @@ func NewHomeWizardFromConfig(other map[string]interface{}) (api.Charger, error)
 
 // NewHomeWizard creates HomeWizard charger
 func NewHomeWizard(embed embed, uri string, standbypower float64, cache time.Duration) (*HomeWizard, error) {
-	conn, err := homewizard.NewConnection(uri, cache)
+	conn, err := homewizard.NewConnection(uri, cache, request.Timeout)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,18 @@
+package charger
+
+import (
+	"testing"
+
+	"go.uber.org/goleak"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m,
+		// ocpp central system is a process-wide singleton
+		goleak.IgnoreAnyFunction("github.com/lorenzodonini/ocpp-go/ocppj.(*Server).Start"),
+		goleak.IgnoreAnyFunction("github.com/lorenzodonini/ocpp-go/ocppj.(*DefaultServerDispatcher).messagePump"),
+		goleak.IgnoreAnyFunction("github.com/evcc-io/evcc/charger/ocpp.(*CS).errorHandler"),
+		// template tests don't close the chargers' modbus connections
+		goleak.IgnoreAnyFunction("github.com/evcc-io/evcc/charger.NewABB.func1"),
+	)
+}
@@ -0,0 +1,40 @@
+package charger
+
+import (
+	"fmt"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+// iecMinCurrent is the minimum charge current mandated by IEC 61851
+const iecMinCurrent = 6.0
+
+// minCurrent enforces the charger's hardware minimum current as floor for current limits.
+// Drivers opt in by embedding it and exposing the minCurrent config key, other drivers are unaffected.
+type minCurrent struct {
+	log                *util.Logger
+	hardwareMinCurrent float64
+}
+
+// newMinCurrent creates the current floor, defaulting to the IEC 61851 minimum current
+func newMinCurrent(log *util.Logger, current float64) (minCurrent, error) {
+	if current < 0 {
+		return minCurrent{}, fmt.Errorf("invalid min current: %.1fA", current)
+	}
+
+	if current == 0 {
+		current = iecMinCurrent
+	}
+
+	return minCurrent{log: log, hardwareMinCurrent: current}, nil
+}
+
+// clamp raises the current to the hardware minimum current
+func (m *minCurrent) clamp(current float64) float64 {
+	if current < m.hardwareMinCurrent {
+		m.log.WARN.Printf("current %.1fA below minimum, using %.1fA", current, m.hardwareMinCurrent)
+		return m.hardwareMinCurrent
+	}
+
+	return current
+}
@@ -0,0 +1,308 @@
+package charger
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/provider"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/modbus"
+	"gopkg.in/yaml.v3"
+)
+
+// ModbusCustom charger implementation using a configurable register map
+type ModbusCustom struct {
+	*embed
+	statusG     func() (int64, error)
+	states      map[int64]api.ChargeStatus
+	enabledG    func() (bool, error)
+	enableS     func(bool) error
+	maxCurrentS func(float64) error
+}
+
+// modbusCustomRegister is a register map entry.
+// Values read are multiplied by scale, values written are multiplied by scale before writing.
+type modbusCustomRegister struct {
+	modbus.Register `mapstructure:",squash"`
+	Scale           float64
+}
+
+// modbusCustomRegisters is the register map of a modbus charger
+type modbusCustomRegisters struct {
+	Status struct {
+		modbusCustomRegister `mapstructure:",squash"`
+		States               map[string]string // register value to charge status A..F
+	}
+	Enable     modbusCustomRegister  // write 1/0 to enable/disable
+	Enabled    *modbusCustomRegister // non-zero if enabled, defaults to reading the enable register
+	MaxCurrent modbusCustomRegister  // write max current (A)
+	Phases     *modbusCustomRegister // write 1/3 to switch phases
+	Power      *modbusCustomRegister // charge power (W)
+	Energy     *modbusCustomRegister // total energy (kWh)
+	Currents   []modbusCustomRegister
+	Voltages   []modbusCustomRegister
+}
+
+func init() {
+	registry.Add("modbus-custom", NewModbusCustomFromConfig)
+}
+
+//go:generate go run ../cmd/tools/decorate.go -f decorateModbusCustom -b *ModbusCustom -r api.Charger -t "api.ChargerEx,MaxCurrentMillis,func(float64) error" -t "api.PhaseSwitcher,Phases1p3p,func(int) error" -t "api.Meter,CurrentPower,func() (float64, error)" -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)"
+
+// NewModbusCustomFromConfig creates a modbus charger from a register map given inline or as file
+func NewModbusCustomFromConfig(other map[string]interface{}) (api.Charger, error) {
+	cc := struct {
+		embed           `mapstructure:",squash"`
+		modbus.Settings `mapstructure:",squash"`
+		Registers       map[string]interface{}
+		File            string
+		Timeout         time.Duration
+		WriteRateLimit  time.Duration
+	}{
+		Settings: modbus.Settings{
+			ID: 1,
+		},
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	if (cc.File == "") == (cc.Registers == nil) {
+		return nil, errors.New("must have either registers or file")
+	}
+
+	if cc.File != "" {
+		b, err := os.ReadFile(cc.File)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := yaml.Unmarshal(b, &cc.Registers); err != nil {
+			return nil, fmt.Errorf("%s: %w", cc.File, err)
+		}
+	}
+
+	var regs modbusCustomRegisters
+	if err := util.DecodeOther(cc.Registers, &regs); err != nil {
+		return nil, fmt.Errorf("registers: %w", err)
+	}
+
+	conn, err := modbus.NewConnection(cc.URI, cc.Device, cc.Comset, cc.Baudrate, cc.Protocol(), cc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cc.Timeout > 0 {
+		conn.Timeout(cc.Timeout)
+	}
+
+	log := util.NewLogger("modbus-custom")
+	conn.Logger(log.TRACE)
+	conn.WriteRateLimit(cc.WriteRateLimit)
+
+	return newModbusCustom(log, conn, &cc.embed, regs)
+}
+
+// newModbusCustom creates a modbus charger from a register map
+func newModbusCustom(log *util.Logger, conn *modbus.Connection, embed *embed, regs modbusCustomRegisters) (api.Charger, error) {
+	plugin := func(name string, reg modbusCustomRegister) (*provider.Modbus, error) {
+		if reg.Scale == 0 {
+			reg.Scale = 1
+		}
+
+		p, err := provider.NewModbus(log, conn, reg.Register, reg.Scale)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		return p, nil
+	}
+
+	getter := func(name string, reg modbusCustomRegister) (func() (float64, error), error) {
+		p, err := plugin(name, reg)
+		if err != nil {
+			return nil, err
+		}
+		return p.FloatGetter()
+	}
+
+	setter := func(name string, reg modbusCustomRegister) (func(float64) error, error) {
+		p, err := plugin(name, reg)
+		if err != nil {
+			return nil, err
+		}
+		return p.FloatSetter(name)
+	}
+
+	wb := &ModbusCustom{
+		embed:  embed,
+		states: make(map[int64]api.ChargeStatus),
+	}
+
+	// status
+	for k, v := range regs.Status.States {
+		val, err := strconv.ParseInt(k, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("status: invalid register value: %s", k)
+		}
+
+		if wb.states[val], err = api.ChargeStatusString(strings.ToUpper(v)); err != nil {
+			return nil, fmt.Errorf("status: %w", err)
+		}
+	}
+
+	if len(wb.states) == 0 {
+		return nil, errors.New("status: missing states")
+	}
+
+	p, err := plugin("status", regs.Status.modbusCustomRegister)
+	if err != nil {
+		return nil, err
+	}
+
+	if wb.statusG, err = p.IntGetter(); err != nil {
+		return nil, fmt.Errorf("status: %w", err)
+	}
+
+	// enable
+	if p, err = plugin("enable", regs.Enable); err != nil {
+		return nil, err
+	}
+
+	if wb.enableS, err = p.BoolSetter("enable"); err != nil {
+		return nil, fmt.Errorf("enable: %w", err)
+	}
+
+	// enabled, defaults to reading back the enable register
+	enabled := regs.Enabled
+	if enabled == nil {
+		enabled = &modbusCustomRegister{Register: regs.Enable.Register}
+		if strings.EqualFold(enabled.Type, "writecoil") {
+			enabled.Type = "coil"
+		} else {
+			enabled.Type = "holding"
+		}
+	}
+
+	if p, err = plugin("enabled", *enabled); err != nil {
+		return nil, err
+	}
+
+	if wb.enabledG, err = p.BoolGetter(); err != nil {
+		return nil, fmt.Errorf("enabled: %w", err)
+	}
+
+	// max current
+	if wb.maxCurrentS, err = setter("maxcurrent", regs.MaxCurrent); err != nil {
+		return nil, err
+	}
+
+	// register resolution allows fractional currents
+	var maxCurrentMillis func(float64) error
+	if regs.MaxCurrent.Scale > 1 {
+		maxCurrentMillis = wb.maxCurrentS
+	}
+
+	var phases1p3p func(int) error
+	if regs.Phases != nil {
+		set, err := setter("phases", *regs.Phases)
+		if err != nil {
+			return nil, err
+		}
+
+		phases1p3p = func(phases int) error {
+			return set(float64(phases))
+		}
+	}
+
+	var power func() (float64, error)
+	if regs.Power != nil {
+		if power, err = getter("power", *regs.Power); err != nil {
+			return nil, err
+		}
+	}
+
+	var energy func() (float64, error)
+	if regs.Energy != nil {
+		if energy, err = getter("energy", *regs.Energy); err != nil {
+			return nil, err
+		}
+	}
+
+	phaseGetter := func(name string, regs []modbusCustomRegister) (func() (float64, float64, float64, error), error) {
+		if len(regs) == 0 {
+			return nil, nil
+		}
+
+		if len(regs) != 3 {
+			return nil, fmt.Errorf("%s: need one register per phase", name)
+		}
+
+		var g [3]func() (float64, error)
+		for i, reg := range regs {
+			var err error
+			if g[i], err = getter(name, reg); err != nil {
+				return nil, err
+			}
+		}
+
+		return func() (float64, float64, float64, error) {
+			var res [3]float64
+			for i, g := range g {
+				var err error
+				if res[i], err = g(); err != nil {
+					return 0, 0, 0, err
+				}
+			}
+			return res[0], res[1], res[2], nil
+		}, nil
+	}
+
+	currents, err := phaseGetter("currents", regs.Currents)
+	if err != nil {
+		return nil, err
+	}
+
+	voltages, err := phaseGetter("voltages", regs.Voltages)
+	if err != nil {
+		return nil, err
+	}
+
+	return decorateModbusCustom(wb, maxCurrentMillis, phases1p3p, power, energy, currents, voltages), nil
+}
+
+// Status implements the api.Charger interface
+func (wb *ModbusCustom) Status() (api.ChargeStatus, error) {
+	s, err := wb.statusG()
+	if err != nil {
+		return api.StatusNone, err
+	}
+
+	res, ok := wb.states[s]
+	if !ok {
+		return api.StatusNone, fmt.Errorf("invalid status: %d", s)
+	}
+
+	return res, nil
+}
+
+// Enabled implements the api.Charger interface
+func (wb *ModbusCustom) Enabled() (bool, error) {
+	return wb.enabledG()
+}
+
+// Enable implements the api.Charger interface
+func (wb *ModbusCustom) Enable(enable bool) error {
+	return wb.enableS(enable)
+}
+
+// MaxCurrent implements the api.Charger interface
+func (wb *ModbusCustom) MaxCurrent(current int64) error {
+	return wb.maxCurrentS(float64(current))
+}
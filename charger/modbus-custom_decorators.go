@@ -0,0 +1,1273 @@
+package charger
+
+// Code generated by github.com/evcc-io/evcc/cmd/tools/decorate.go. DO NOT EDIT.
+
+import (
+	"github.com/evcc-io/evcc/api"
+)
+
+func decorateModbusCustom(base *ModbusCustom, chargerEx func(float64) error, phaseSwitcher func(int) error, meter func() (float64, error), meterEnergy func() (float64, error), phaseCurrents func() (float64, float64, float64, error), phaseVoltages func() (float64, float64, float64, error)) api.Charger {
+	switch {
+	case chargerEx == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return base
+
+	case chargerEx != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.MeterEnergy
+		}{
+			ModbusCustom: base,
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.MeterEnergy
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.MeterEnergy
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.PhaseCurrents
+		}{
+			ModbusCustom: base,
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.PhaseCurrents
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.PhaseCurrents
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			ModbusCustom: base,
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*ModbusCustom
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			ModbusCustom: base,
+			ChargerEx: &decorateModbusCustomChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateModbusCustomMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateModbusCustomMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusCustomPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateModbusCustomPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateModbusCustomPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+	}
+
+	return nil
+}
+
+type decorateModbusCustomChargerExImpl struct {
+	chargerEx func(float64) error
+}
+
+func (impl *decorateModbusCustomChargerExImpl) MaxCurrentMillis(p0 float64) error {
+	return impl.chargerEx(p0)
+}
+
+type decorateModbusCustomMeterImpl struct {
+	meter func() (float64, error)
+}
+
+func (impl *decorateModbusCustomMeterImpl) CurrentPower() (float64, error) {
+	return impl.meter()
+}
+
+type decorateModbusCustomMeterEnergyImpl struct {
+	meterEnergy func() (float64, error)
+}
+
+func (impl *decorateModbusCustomMeterEnergyImpl) TotalEnergy() (float64, error) {
+	return impl.meterEnergy()
+}
+
+type decorateModbusCustomPhaseCurrentsImpl struct {
+	phaseCurrents func() (float64, float64, float64, error)
+}
+
+func (impl *decorateModbusCustomPhaseCurrentsImpl) Currents() (float64, float64, float64, error) {
+	return impl.phaseCurrents()
+}
+
+type decorateModbusCustomPhaseSwitcherImpl struct {
+	phaseSwitcher func(int) error
+}
+
+func (impl *decorateModbusCustomPhaseSwitcherImpl) Phases1p3p(p0 int) error {
+	return impl.phaseSwitcher(p0)
+}
+
+type decorateModbusCustomPhaseVoltagesImpl struct {
+	phaseVoltages func() (float64, float64, float64, error)
+}
+
+func (impl *decorateModbusCustomPhaseVoltagesImpl) Voltages() (float64, float64, float64, error) {
+	return impl.phaseVoltages()
+}
@@ -0,0 +1,70 @@
+package charger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util/modbus/simulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const modbusCustomRegisterMap = `
+status:
+  address: 100
+  type: input
+  encoding: uint16
+  states: {0: A, 1: B, 2: C}
+enable:
+  address: 200
+  type: writesingle
+  encoding: uint16
+maxcurrent:
+  address: 201
+  type: writesingle
+  encoding: uint16
+  scale: 100
+power:
+  address: 300
+  type: input
+  encoding: uint16
+`
+
+func TestModbusCustom(t *testing.T) {
+	sim, err := simulator.NewRTUSimulator(map[uint16]uint16{100: 2, 200: 0, 201: 0, 300: 4200})
+	require.NoError(t, err)
+	defer sim.Close()
+
+	file := filepath.Join(t.TempDir(), "charger.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(modbusCustomRegisterMap), 0o644))
+
+	wb, err := NewModbusCustomFromConfig(map[string]interface{}{
+		"uri":  sim.Addr(),
+		"rtu":  true,
+		"file": file,
+	})
+	require.NoError(t, err)
+
+	status, err := wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+
+	require.NoError(t, wb.Enable(true))
+	enabled, err := wb.Enabled()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	// register resolution allows fractional currents
+	require.Implements(t, (*api.ChargerEx)(nil), wb)
+	require.NoError(t, wb.(api.ChargerEx).MaxCurrentMillis(6.5))
+	assert.Equal(t, uint16(650), sim.Register(201))
+
+	require.Implements(t, (*api.Meter)(nil), wb)
+	power, err := wb.(api.Meter).CurrentPower()
+	require.NoError(t, err)
+	assert.Equal(t, 4200.0, power)
+
+	require.NotImplements(t, (*api.PhaseSwitcher)(nil), wb)
+}
@@ -31,6 +31,7 @@ type OCPP struct {
 	timeout           time.Duration
 	phaseSwitching    bool
 	chargingRateUnit  types.ChargingRateUnitType
+	maxCurrentKey     string
 	lp                loadpoint.API
 }
 
@@ -53,6 +54,7 @@ func NewOCPPFromConfig(other map[string]interface{}) (api.Charger, error) {
 		BootNotification *bool
 		GetConfiguration *bool
 		ChargingRateUnit string
+		MaxCurrentKey    string
 	}{
 		Connector:        1,
 		IdTag:            defaultIdTag,
@@ -76,6 +78,9 @@ func NewOCPPFromConfig(other map[string]interface{}) (api.Charger, error) {
 		return c, err
 	}
 
+	// use vendor configuration key instead of charging profiles
+	c.maxCurrentKey = cc.MaxCurrentKey
+
 	var powerG func() (float64, error)
 	if c.hasMeasurement(types.MeasurandPowerActiveImport) {
 		powerG = c.currentPower
@@ -458,7 +463,13 @@ var _ api.ChargerEx = (*OCPP)(nil)
 
 // MaxCurrentMillis implements the api.ChargerEx interface
 func (c *OCPP) MaxCurrentMillis(current float64) error {
-	err := c.updatePeriod(current)
+	var err error
+	if c.maxCurrentKey != "" {
+		err = c.configure(c.maxCurrentKey, strconv.FormatFloat(math.Trunc(10*current)/10, 'f', -1, 64))
+	} else {
+		err = c.updatePeriod(current)
+	}
+
 	if err == nil {
 		c.current = current
 	}
@@ -131,7 +131,8 @@ func (conn *Connector) Status() (api.ChargeStatus, error) {
 
 	switch conn.status.Status {
 	case core.ChargePointStatusAvailable, // "Available"
-		core.ChargePointStatusUnavailable: // "Unavailable"
+		core.ChargePointStatusUnavailable, // "Unavailable"
+		core.ChargePointStatusReserved:    // "Reserved"
 		res = api.StatusA
 	case
 		core.ChargePointStatusPreparing,     // "Preparing"
@@ -141,9 +142,8 @@ func (conn *Connector) Status() (api.ChargeStatus, error) {
 		res = api.StatusB
 	case core.ChargePointStatusCharging: // "Charging"
 		res = api.StatusC
-	case core.ChargePointStatusReserved, // "Reserved"
-		core.ChargePointStatusFaulted: // "Faulted"
-		return api.StatusF, fmt.Errorf("chargepoint status: %s", conn.status.ErrorCode)
+	case core.ChargePointStatusFaulted: // "Faulted"
+		return api.StatusF, fmt.Errorf("chargepoint status: %s", conn.status.Status)
 	default:
 		return api.StatusNone, fmt.Errorf("invalid chargepoint status: %s", conn.status.Status)
 	}
@@ -53,6 +53,13 @@ func (suite *ocppTestSuite) startChargePoint(id string, connectorId int) ocpp16.
 		}
 	}()
 
+	suite.T().Cleanup(func() {
+		if cp.IsConnected() {
+			cp.Stop()
+		}
+		close(handler.triggerC)
+	})
+
 	return cp
 }
 
@@ -20,6 +20,8 @@ package charger
 import (
 	"encoding/binary"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util"
@@ -30,8 +32,14 @@ import (
 
 // Sungrow charger implementation
 type Sungrow struct {
-	log  *util.Logger
-	conn *modbus.Connection
+	log            *util.Logger
+	conn           *modbus.Connection
+	registerOffset uint16 // connector register offset
+	eventInterval  time.Duration
+	eventsOnce     sync.Once
+	events         <-chan api.ChargerEvent
+	socLimit       bool // vehicle soc limit supported by firmware
+	minCurrent
 }
 
 const (
@@ -40,6 +48,7 @@ const (
 	sgRegMaxCurrent  = 21202 // uint16 0.01A
 	sgRegPhases      = 21203 // uint16
 	sgRegWorkingMode = 21262 // uint16 [Network=0, Plug&Play=2, EMS=6]
+	sgRegSocLimit    = 21320 // uint16 %, recent firmware only
 
 	// input
 	sgRegPhasesPower   = 21224 // uint16
@@ -49,6 +58,8 @@ const (
 	sgRegChargedEnergy = 21309 // uint32s 1Wh
 	sgRegStartMode     = 21313 // uint16 [EMS=1, Swiping=2]
 	sgRegState         = 21316 // uint16
+
+	sgConnectorOffset = 100 // register offset per connector
 )
 
 var (
@@ -62,19 +73,34 @@ func init() {
 
 // NewSungrowFromConfig creates a Sungrow charger from generic config
 func NewSungrowFromConfig(other map[string]interface{}) (api.Charger, error) {
-	cc := modbus.Settings{
-		ID: 248,
+	cc := struct {
+		modbus.Settings  `mapstructure:",squash"`
+		ValidateRegister *modbus.Validation
+		Connector        int
+		MinCurrent       float64
+		WriteRateLimit   time.Duration
+		EventInterval    time.Duration
+		StaleDataTimeout time.Duration
+	}{
+		Settings: modbus.Settings{
+			ID: 248,
+		},
+		Connector: 1,
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
 		return nil, err
 	}
 
-	return NewSungrow(cc.URI, cc.Device, cc.Comset, cc.Baudrate, modbus.ProtocolFromRTU(cc.RTU), cc.ID)
+	return NewSungrow(cc.URI, cc.Device, cc.Comset, cc.Baudrate, cc.Protocol(), cc.ID, cc.Connector, cc.MinCurrent, cc.ValidateRegister, cc.WriteRateLimit, cc.EventInterval, cc.StaleDataTimeout)
 }
 
 // NewSungrow creates Sungrow charger
-func NewSungrow(uri, device, comset string, baudrate int, proto modbus.Protocol, id uint8) (api.Charger, error) {
+func NewSungrow(uri, device, comset string, baudrate int, proto modbus.Protocol, id uint8, connector int, minCurrent float64, validation *modbus.Validation, writeRateLimit, eventInterval, staleDataTimeout time.Duration) (api.Charger, error) {
+	if connector < 1 || connector > 2 {
+		return nil, fmt.Errorf("invalid connector: %d", connector)
+	}
+
 	conn, err := modbus.NewConnection(uri, device, comset, baudrate, proto, id)
 	if err != nil {
 		return nil, err
@@ -87,32 +113,78 @@ func NewSungrow(uri, device, comset string, baudrate int, proto modbus.Protocol,
 	log := util.NewLogger("sungrow")
 	conn.Logger(log.TRACE)
 
+	floor, err := newMinCurrent(log, minCurrent)
+	if err != nil {
+		return nil, err
+	}
+	conn.WriteRateLimit(writeRateLimit)
+
+	// bridge brief outages with cached values
+	conn.StaleDataTimeout(staleDataTimeout)
+
+	// optionally verify slave id by reading a known register
+	if validation != nil {
+		if err := validation.Validate(conn); err != nil {
+			return nil, err
+		}
+	}
+
 	wb := &Sungrow{
-		log:  log,
-		conn: conn,
+		log:            log,
+		conn:           conn,
+		registerOffset: uint16(connector-1) * sgConnectorOffset,
+		minCurrent:     floor,
+		eventInterval:  eventInterval,
+	}
+
+	// vehicle soc limit is only available with recent firmware
+	if _, err := conn.ReadHoldingRegisters(wb.register(sgRegSocLimit), 1); err == nil {
+		wb.socLimit = true
+	} else {
+		log.DEBUG.Println("vehicle soc limit not supported:", err)
 	}
 
-	return wb, err
+	return wb, nil
 }
 
-// getPhaseValues returns 3 non-sequential register values
+// register returns the register address for the configured connector
+func (wb *Sungrow) register(reg uint16) uint16 {
+	return reg + wb.registerOffset
+}
+
+// getPhaseValues returns 3 non-sequential register values using a single read
 func (wb *Sungrow) getPhaseValues(regs []uint16, divider float64) (float64, float64, float64, error) {
-	var res [3]float64
-	for i, reg := range regs {
-		b, err := wb.conn.ReadInputRegisters(reg, 1)
-		if err != nil {
-			return 0, 0, 0, err
-		}
+	ranges := make([]modbus.Range, 0, len(regs))
+	for _, reg := range regs {
+		ranges = append(ranges, modbus.Range{Address: wb.register(reg), Count: 1})
+	}
+
+	b, err := modbus.NewRegisterGroup(wb.readInputRegisters, 1, ranges...).Read()
+	if err != nil {
+		return 0, 0, 0, err
+	}
 
-		res[i] = rs485.RTUUint16ToFloat64(b) / divider
+	var res [3]float64
+	for i, r := range ranges {
+		res[i] = rs485.RTUUint16ToFloat64(b[r.Address]) / divider
 	}
 
 	return res[0], res[1], res[2], nil
 }
 
+// readInputRegisters reads input registers, accepting cached values during outages
+func (wb *Sungrow) readInputRegisters(address, quantity uint16) ([]byte, error) {
+	return modbus.IgnoreCached(wb.conn.ReadInputRegisters(address, quantity))
+}
+
+// readHoldingRegisters reads holding registers, accepting cached values during outages
+func (wb *Sungrow) readHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return modbus.IgnoreCached(wb.conn.ReadHoldingRegisters(address, quantity))
+}
+
 // Status implements the api.Charger interface
 func (wb *Sungrow) Status() (api.ChargeStatus, error) {
-	b, err := wb.conn.ReadInputRegisters(sgRegState, 1)
+	b, err := wb.readInputRegisters(wb.register(sgRegState), 1)
 	if err != nil {
 		return api.StatusNone, err
 	}
@@ -138,9 +210,19 @@ func (wb *Sungrow) Status() (api.ChargeStatus, error) {
 	}
 }
 
+var _ api.EventSource = (*Sungrow)(nil)
+
+// Events implements the api.EventSource interface
+func (wb *Sungrow) Events() <-chan api.ChargerEvent {
+	wb.eventsOnce.Do(func() {
+		wb.events = statusEvents(wb.conn.Context(), wb.log, wb.Status, wb.eventInterval)
+	})
+	return wb.events
+}
+
 // Enabled implements the api.Charger interface
 func (wb *Sungrow) Enabled() (bool, error) {
-	b, err := wb.conn.ReadHoldingRegisters(sgRegEnable, 1)
+	b, err := wb.readHoldingRegisters(wb.register(sgRegEnable), 1)
 	if err != nil {
 		return false, err
 	}
@@ -155,9 +237,18 @@ func (wb *Sungrow) Enable(enable bool) error {
 		u = 1
 	}
 
-	_, err := wb.conn.WriteSingleRegister(sgRegEnable, u)
+	// write and read back in a single transaction
+	reg := wb.register(sgRegEnable)
+	b, err := wb.conn.WriteReadRegisters(reg, reg, binary.BigEndian.AppendUint16(nil, u), 1)
+	if err != nil {
+		return err
+	}
 
-	return err
+	if res := binary.BigEndian.Uint16(b); res != u {
+		return fmt.Errorf("enable not accepted: got %d, expected %d", res, u)
+	}
+
+	return nil
 }
 
 // MaxCurrent implements the api.Charger interface
@@ -169,11 +260,40 @@ var _ api.ChargerEx = (*Sungrow)(nil)
 
 // MaxCurrentMillis implements the api.ChargerEx interface
 func (wb *Sungrow) MaxCurrentMillis(current float64) error {
-	if current < 6 {
-		return fmt.Errorf("invalid current %.1f", current)
+	current = wb.clamp(current)
+
+	_, err := wb.conn.WriteSingleRegister(wb.register(sgRegMaxCurrent), uint16(current*10))
+
+	return err
+}
+
+var _ api.VehicleSocLimiter = (*Sungrow)(nil)
+
+// VehicleSocLimit implements the api.VehicleSocLimiter interface
+func (wb *Sungrow) VehicleSocLimit() (int64, error) {
+	if !wb.socLimit {
+		return 0, api.ErrNotAvailable
+	}
+
+	b, err := wb.readHoldingRegisters(wb.register(sgRegSocLimit), 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(binary.BigEndian.Uint16(b)), nil
+}
+
+// SetVehicleSocLimit implements the api.VehicleSocLimiter interface
+func (wb *Sungrow) SetVehicleSocLimit(soc int64) error {
+	if !wb.socLimit {
+		return api.ErrNotAvailable
+	}
+
+	if soc < 0 || soc > 100 {
+		return fmt.Errorf("invalid soc limit: %d", soc)
 	}
 
-	_, err := wb.conn.WriteSingleRegister(sgRegMaxCurrent, uint16(current*10))
+	_, err := wb.conn.WriteSingleRegister(wb.register(sgRegSocLimit), uint16(soc))
 
 	return err
 }
@@ -182,12 +302,13 @@ var _ api.Meter = (*Sungrow)(nil)
 
 // CurrentPower implements the api.Meter interface
 func (wb *Sungrow) CurrentPower() (float64, error) {
-	b, err := wb.conn.ReadHoldingRegisters(sgRegActivePower, 2)
+	b, err := wb.readHoldingRegisters(wb.register(sgRegActivePower), 2)
 	if err != nil {
 		return 0, err
 	}
 
-	return rs485.RTUUint32ToFloat64Swapped(b), err
+	// signed for bidirectional firmware
+	return modbus.RTUInt32ToFloat64Swapped(b), err
 }
 
 var _ api.PhaseCurrents = (*Sungrow)(nil)
@@ -208,7 +329,7 @@ var _ api.ChargeRater = (*Sungrow)(nil)
 
 // ChargedEnergy implements the api.MeterEnergy interface
 func (wb *Sungrow) ChargedEnergy() (float64, error) {
-	b, err := wb.conn.ReadHoldingRegisters(sgRegChargedEnergy, 2)
+	b, err := wb.readHoldingRegisters(wb.register(sgRegChargedEnergy), 2)
 	if err != nil {
 		return 0, err
 	}
@@ -220,7 +341,7 @@ var _ api.MeterEnergy = (*Sungrow)(nil)
 
 // TotalEnergy implements the api.MeterEnergy interface
 func (wb *Sungrow) TotalEnergy() (float64, error) {
-	b, err := wb.conn.ReadHoldingRegisters(sgRegTotalEnergy, 2)
+	b, err := wb.readHoldingRegisters(wb.register(sgRegTotalEnergy), 2)
 	if err != nil {
 		return 0, err
 	}
@@ -238,7 +359,7 @@ func (wb *Sungrow) Phases1p3p(phases int) error {
 		u = 1
 	}
 
-	_, err := wb.conn.WriteSingleRegister(sgRegPhases, u)
+	_, err := wb.conn.WriteSingleRegister(wb.register(sgRegPhases), u)
 
 	return err
 }
@@ -247,28 +368,28 @@ var _ api.Diagnosis = (*Sungrow)(nil)
 
 // Diagnose implements the api.Diagnosis interface
 func (wb *Sungrow) Diagnose() {
-	if b, err := wb.conn.ReadHoldingRegisters(sgRegMaxCurrent, 1); err == nil {
+	if b, err := wb.conn.ReadHoldingRegisters(wb.register(sgRegMaxCurrent), 1); err == nil {
 		fmt.Printf("\tMaxCurrent:\t%d\n", binary.BigEndian.Uint16(b))
 	}
-	if b, err := wb.conn.ReadHoldingRegisters(sgRegPhases, 1); err == nil {
+	if b, err := wb.conn.ReadHoldingRegisters(wb.register(sgRegPhases), 1); err == nil {
 		fmt.Printf("\tPhases:\t%d\n", binary.BigEndian.Uint16(b))
 	}
-	if b, err := wb.conn.ReadHoldingRegisters(sgRegEnable, 1); err == nil {
+	if b, err := wb.conn.ReadHoldingRegisters(wb.register(sgRegEnable), 1); err == nil {
 		fmt.Printf("\tEnable:\t%d\n", binary.BigEndian.Uint16(b))
 	}
-	if b, err := wb.conn.ReadHoldingRegisters(sgRegWorkingMode, 1); err == nil {
+	if b, err := wb.conn.ReadHoldingRegisters(wb.register(sgRegWorkingMode), 1); err == nil {
 		fmt.Printf("\tWorkingMode:\t%d\n", binary.BigEndian.Uint16(b))
 	}
-	if b, err := wb.conn.ReadInputRegisters(sgRegPhasesPower, 1); err == nil {
+	if b, err := wb.conn.ReadInputRegisters(wb.register(sgRegPhasesPower), 1); err == nil {
 		fmt.Printf("\tPhasesPower:\t%d\n", binary.BigEndian.Uint16(b))
 	}
-	if b, err := wb.conn.ReadInputRegisters(sgRegPhasesState, 1); err == nil {
+	if b, err := wb.conn.ReadInputRegisters(wb.register(sgRegPhasesState), 1); err == nil {
 		fmt.Printf("\tPhasesState:\t%d\n", binary.BigEndian.Uint16(b))
 	}
-	if b, err := wb.conn.ReadInputRegisters(sgRegStartMode, 1); err == nil {
+	if b, err := wb.conn.ReadInputRegisters(wb.register(sgRegStartMode), 1); err == nil {
 		fmt.Printf("\tStartMode:\t%d\n", binary.BigEndian.Uint16(b))
 	}
-	if b, err := wb.conn.ReadInputRegisters(sgRegState, 1); err == nil {
+	if b, err := wb.conn.ReadInputRegisters(wb.register(sgRegState), 1); err == nil {
 		fmt.Printf("\tState:\t%d\n", binary.BigEndian.Uint16(b))
 	}
 }
@@ -0,0 +1,125 @@
+package charger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/evcc-io/evcc/util/modbus/simulator"
+	"github.com/evcc-io/evcc/util/sponsor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSungrowSimulator(t *testing.T) {
+	sponsor.Subject = "foo"
+
+	sim, err := simulator.NewRTUSimulator(map[uint16]uint16{
+		sgRegState:                     3,
+		sgRegEnable:                    0,
+		sgRegMaxCurrent:                60,
+		sgRegPhases:                    0,
+		sgRegActivePower:               0x1234, // swapped word order
+		sgRegActivePower + 1:           0x0000,
+		sgRegCurrents[0]:               160,
+		sgRegCurrents[1]:               161,
+		sgRegCurrents[2]:               162,
+		sgRegVoltages[0]:               2300,
+		sgRegVoltages[1]:               2310,
+		sgRegVoltages[2]:               2320,
+		sgRegSocLimit:                  100,
+		sgRegState + sgConnectorOffset: 1,
+	})
+	require.NoError(t, err)
+	defer sim.Close()
+
+	wb, err := NewSungrow(sim.Addr(), "", "", 0, modbus.Rtu, 248, 1, 0, nil, 0, 0, 0)
+	require.NoError(t, err)
+	t.Cleanup(func() { wb.(*Sungrow).conn.Close() })
+
+	status, err := wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+
+	require.NoError(t, wb.MaxCurrent(16))
+	assert.Equal(t, uint16(160), sim.Register(sgRegMaxCurrent))
+
+	// currents below hardware minimum are raised
+	require.NoError(t, wb.(api.ChargerEx).MaxCurrentMillis(4))
+	assert.Equal(t, uint16(60), sim.Register(sgRegMaxCurrent))
+
+	require.NoError(t, wb.Enable(true))
+	assert.Equal(t, uint16(1), sim.Register(sgRegEnable))
+
+	enabled, err := wb.Enabled()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	require.NoError(t, wb.(api.PhaseSwitcher).Phases1p3p(1))
+	assert.Equal(t, uint16(1), sim.Register(sgRegPhases))
+
+	power, err := wb.(api.Meter).CurrentPower()
+	require.NoError(t, err)
+	assert.Equal(t, float64(0x1234), power)
+
+	l1, l2, l3, err := wb.(api.PhaseCurrents).Currents()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{16, 16.1, 16.2}, []float64{l1, l2, l3})
+
+	u1, u2, u3, err := wb.(api.PhaseVoltages).Voltages()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{230, 231, 232}, []float64{u1, u2, u3})
+
+	sl := wb.(api.VehicleSocLimiter)
+	require.NoError(t, sl.SetVehicleSocLimit(80))
+	limit, err := sl.VehicleSocLimit()
+	require.NoError(t, err)
+	assert.Equal(t, int64(80), limit)
+
+	// second connector uses offset registers
+	wb2, err := NewSungrow(sim.Addr(), "", "", 0, modbus.Rtu, 248, 2, 0, nil, 0, 0, 0)
+	require.NoError(t, err)
+	t.Cleanup(func() { wb2.(*Sungrow).conn.Close() })
+
+	status, err = wb2.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusA, status)
+
+	// vehicle soc limit not supported
+	_, err = wb2.(api.VehicleSocLimiter).VehicleSocLimit()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	// missing registers return modbus exceptions
+	_, err = wb2.Enabled()
+	var ee *modbus.ExceptionError
+	assert.ErrorAs(t, err, &ee)
+}
+
+func TestSungrowOutage(t *testing.T) {
+	sponsor.Subject = "foo"
+
+	sim, err := simulator.NewRTUSimulator(map[uint16]uint16{
+		sgRegState: 3,
+	})
+	require.NoError(t, err)
+
+	wb, err := NewSungrow(sim.Addr(), "", "", 0, modbus.Rtu, 248, 1, 0, nil, 0, 0, 10*time.Second)
+	require.NoError(t, err)
+	conn := wb.(*Sungrow).conn
+	t.Cleanup(func() { conn.Close() })
+	conn.Timeout(500 * time.Millisecond)
+
+	status, err := wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+
+	// device unreachable for 2s
+	require.NoError(t, sim.Close())
+
+	for start := time.Now(); time.Since(start) < 2*time.Second; {
+		status, err := wb.Status()
+		require.NoError(t, err)
+		assert.Equal(t, api.StatusC, status)
+	}
+}
@@ -42,7 +42,9 @@ const (
 	vestelRegSessionEnergy   = 1502
 	vestelRegFailsafeTimeout = 2002
 	vestelRegAlive           = 6000
-	// vestelRegChargepointState = 1000
+
+	vestelRegChargepointState = 1000 // ocpp status
+	vestelChargepointFaulted  = 8
 )
 
 var (
@@ -123,9 +125,18 @@ func (wb *Vestel) heartbeat(timeout time.Duration) {
 
 // Status implements the api.Charger interface
 func (wb *Vestel) Status() (api.ChargeStatus, error) {
+	b, err := wb.conn.ReadInputRegisters(vestelRegChargepointState, 1)
+	if err != nil {
+		return api.StatusNone, err
+	}
+
+	if binary.BigEndian.Uint16(b) == vestelChargepointFaulted {
+		return api.StatusF, nil
+	}
+
 	res := api.StatusA
 
-	b, err := wb.conn.ReadInputRegisters(vestelRegCableStatus, 1)
+	b, err = wb.conn.ReadInputRegisters(vestelRegCableStatus, 1)
 	if err == nil && binary.BigEndian.Uint16(b) >= 2 {
 		res = api.StatusB
 
@@ -273,6 +284,9 @@ func (wb *Vestel) Diagnose() {
 	if b, err := wb.conn.ReadInputRegisters(vestelRegFirmware, 50); err == nil {
 		fmt.Printf("Firmware:\t%s\n", b)
 	}
+	if b, err := wb.conn.ReadInputRegisters(vestelRegChargepointState, 1); err == nil {
+		fmt.Printf("Chargepoint state:\t%d\n", binary.BigEndian.Uint16(b))
+	}
 	if b, err := wb.conn.ReadHoldingRegisters(vestelRegFailsafeTimeout, 1); err == nil {
 		fmt.Printf("Failsafe timeout (plain):\t%d\n", binary.BigEndian.Uint16(b))
 	}
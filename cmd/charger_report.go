@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/spf13/cobra"
+)
+
+// chargerTestCmd represents the charger test command
+var chargerTestCmd = &cobra.Command{
+	Use:   "test [name]",
+	Short: "Test all implemented charger interfaces and print a compatibility report",
+	Args:  cobra.MaximumNArgs(1),
+	Run:   runChargerTest,
+}
+
+func init() {
+	chargerCmd.AddCommand(chargerTestCmd)
+
+	chargerTestCmd.Flags().Bool(flagWrite, false, flagWriteDescription)
+}
+
+// reportRow is a single interface method result of the compatibility report
+type reportRow struct {
+	iface, method, result string
+}
+
+// chargerReport calls all implemented charger interface methods. Write operations are only executed if write is set
+// and leave the charger in its current state: the enabled state is written back and the current is set to 6A.
+func chargerReport(c api.Charger, write bool) []reportRow {
+	var res []reportRow
+
+	check := func(iface, method string, ok bool, fn func() (string, error)) {
+		row := reportRow{iface: iface, method: method}
+
+		switch {
+		case !ok:
+			row.result = "-"
+		case fn == nil:
+			row.result = "implemented, not executed"
+		default:
+			if val, err := fn(); err != nil {
+				row.result = "error: " + err.Error()
+			} else {
+				row.result = val
+			}
+		}
+
+		res = append(res, row)
+	}
+
+	// only executed in write mode
+	writeOp := func(fn func() (string, error)) func() (string, error) {
+		if write {
+			return fn
+		}
+		return nil
+	}
+
+	check("Charger", "Status", true, func() (string, error) {
+		status, err := c.Status()
+		return string(status), err
+	})
+
+	check("Charger", "Enabled", true, func() (string, error) {
+		enabled, err := c.Enabled()
+		return fmt.Sprintf("%t", enabled), err
+	})
+
+	check("Charger", "Enable", true, writeOp(func() (string, error) {
+		enabled, err := c.Enabled()
+		if err == nil {
+			err = c.Enable(enabled)
+		}
+		return fmt.Sprintf("%t", enabled), err
+	}))
+
+	check("Charger", "MaxCurrent", true, writeOp(func() (string, error) {
+		return "6A", c.MaxCurrent(6)
+	}))
+
+	cex, ok := c.(api.ChargerEx)
+	check("ChargerEx", "MaxCurrentMillis", ok, writeOp(func() (string, error) {
+		return "6A", cex.MaxCurrentMillis(6)
+	}))
+
+	_, ok = c.(api.PhaseSwitcher)
+	check("PhaseSwitcher", "Phases1p3p", ok, nil)
+
+	pg, ok := c.(api.PhaseGetter)
+	check("PhaseGetter", "GetPhases", ok, func() (string, error) {
+		phases, err := pg.GetPhases()
+		return fmt.Sprintf("%dp", phases), err
+	})
+
+	cg, ok := c.(api.CurrentGetter)
+	check("CurrentGetter", "GetMaxCurrent", ok, func() (string, error) {
+		current, err := cg.GetMaxCurrent()
+		return fmt.Sprintf("%.3gA", current), err
+	})
+
+	cl, ok := c.(api.CurrentLimiter)
+	check("CurrentLimiter", "GetMinMaxCurrent", ok, func() (string, error) {
+		minCurrent, maxCurrent, err := cl.GetMinMaxCurrent()
+		return fmt.Sprintf("%.3gA..%.3gA", minCurrent, maxCurrent), err
+	})
+
+	m, ok := c.(api.Meter)
+	check("Meter", "CurrentPower", ok, func() (string, error) {
+		power, err := m.CurrentPower()
+		return fmt.Sprintf("%.0fW", power), err
+	})
+
+	me, ok := c.(api.MeterEnergy)
+	check("MeterEnergy", "TotalEnergy", ok, func() (string, error) {
+		energy, err := me.TotalEnergy()
+		return fmt.Sprintf("%.1fkWh", energy), err
+	})
+
+	pc, ok := c.(api.PhaseCurrents)
+	check("PhaseCurrents", "Currents", ok, func() (string, error) {
+		i1, i2, i3, err := pc.Currents()
+		return fmt.Sprintf("%.3gA %.3gA %.3gA", i1, i2, i3), err
+	})
+
+	pv, ok := c.(api.PhaseVoltages)
+	check("PhaseVoltages", "Voltages", ok, func() (string, error) {
+		u1, u2, u3, err := pv.Voltages()
+		return fmt.Sprintf("%.3gV %.3gV %.3gV", u1, u2, u3), err
+	})
+
+	pp, ok := c.(api.PhasePowers)
+	check("PhasePowers", "Powers", ok, func() (string, error) {
+		p1, p2, p3, err := pp.Powers()
+		return fmt.Sprintf("%.3gW %.3gW %.3gW", p1, p2, p3), err
+	})
+
+	cr, ok := c.(api.ChargeRater)
+	check("ChargeRater", "ChargedEnergy", ok, func() (string, error) {
+		energy, err := cr.ChargedEnergy()
+		return fmt.Sprintf("%.1fkWh", energy), err
+	})
+
+	ct, ok := c.(api.ChargeTimer)
+	check("ChargeTimer", "ChargingTime", ok, func() (string, error) {
+		duration, err := ct.ChargingTime()
+		return duration.Truncate(time.Second).String(), err
+	})
+
+	b, ok := c.(api.Battery)
+	check("Battery", "Soc", ok, func() (string, error) {
+		soc, err := b.Soc()
+		return fmt.Sprintf("%.0f%%", soc), err
+	})
+
+	id, ok := c.(api.Identifier)
+	check("Identifier", "Identify", ok, func() (string, error) {
+		return id.Identify()
+	})
+
+	_, ok = c.(api.Resurrector)
+	check("Resurrector", "WakeUp", ok, nil)
+
+	fd, ok := c.(api.FeatureDescriber)
+	check("FeatureDescriber", "Features", ok, func() (string, error) {
+		return fmt.Sprintf("%v", fd.Features()), nil
+	})
+
+	return res
+}
+
+// printReport prints the compatibility report as table
+func printReport(out io.Writer, rows []reportRow) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "Interface\tMethod\tResult")
+	for _, row := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", row.iface, row.method, row.result)
+	}
+
+	w.Flush()
+}
+
+func runChargerTest(cmd *cobra.Command, args []string) {
+	// load config
+	if err := loadConfigFile(&conf); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	// setup environment
+	if err := configureEnvironment(cmd, conf); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	if err := configureChargers(conf.Chargers, args...); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	write := cmd.Flags().Lookup(flagWrite).Changed
+
+	chargers := config.Chargers().Devices()
+	d := dumper{len: len(chargers)}
+
+	for _, dev := range chargers {
+		if d.len > 1 {
+			d.Header(dev.Config().Name, "-")
+		}
+
+		printReport(os.Stdout, chargerReport(dev.Instance(), write))
+
+		if d.len > 1 {
+			fmt.Println()
+		}
+	}
+
+	// wait for shutdown
+	<-shutdownDoneC()
+}
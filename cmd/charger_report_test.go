@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChargerReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	c := struct {
+		*api.MockCharger
+		*api.MockMeter
+	}{
+		api.NewMockCharger(ctrl),
+		api.NewMockMeter(ctrl),
+	}
+
+	c.MockCharger.EXPECT().Status().Return(api.StatusB, nil).Times(2)
+	c.MockCharger.EXPECT().Enabled().Return(true, nil).Times(3)
+	c.MockMeter.EXPECT().CurrentPower().Return(0.0, errors.New("foo")).Times(2)
+
+	result := func(rows []reportRow, iface, method string) string {
+		for _, row := range rows {
+			if row.iface == iface && row.method == method {
+				return row.result
+			}
+		}
+		return ""
+	}
+
+	// read-only
+	rows := chargerReport(c, false)
+	assert.Equal(t, "B", result(rows, "Charger", "Status"))
+	assert.Equal(t, "true", result(rows, "Charger", "Enabled"))
+	assert.Equal(t, "implemented, not executed", result(rows, "Charger", "Enable"))
+	assert.Equal(t, "error: foo", result(rows, "Meter", "CurrentPower"))
+	assert.Equal(t, "-", result(rows, "PhaseCurrents", "Currents"))
+
+	// write
+	c.MockCharger.EXPECT().Enable(true).Return(nil)
+	c.MockCharger.EXPECT().MaxCurrent(int64(6)).Return(nil)
+
+	rows = chargerReport(c, true)
+	assert.Equal(t, "true", result(rows, "Charger", "Enable"))
+	assert.Equal(t, "6A", result(rows, "Charger", "MaxCurrent"))
+}
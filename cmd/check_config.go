@@ -2,20 +2,28 @@ package cmd
 
 import (
 	_ "embed"
+	"errors"
 	"fmt"
+	"net"
 	"os"
+	"strings"
 
+	"github.com/evcc-io/evcc/charger"
+	"github.com/evcc-io/evcc/meter"
+	"github.com/evcc-io/evcc/util/config"
 	"github.com/spf13/cobra"
 )
 
 var checkconfig = &cobra.Command{
 	Use:   "checkconfig",
 	Short: "Check config file for errors",
+	Long:  "Check config file for errors. Creates all configured meters and chargers and reads their current power and status once.",
 	Run:   runConfigCheck,
 }
 
 func init() {
 	rootCmd.AddCommand(checkconfig)
+	checkconfig.Flags().Bool(flagSyntax, false, flagSyntaxDescription)
 }
 
 func runConfigCheck(cmd *cobra.Command, args []string) {
@@ -24,7 +32,73 @@ func runConfigCheck(cmd *cobra.Command, args []string) {
 	if err != nil {
 		log.FATAL.Println("config invalid:", err)
 		os.Exit(1)
-	} else {
+	}
+
+	if syntax, _ := cmd.Flags().GetBool(flagSyntax); syntax {
 		fmt.Println("config valid")
+		return
+	}
+
+	// setup environment
+	if err := configureEnvironment(cmd, conf); err != nil {
+		log.FATAL.Println("config invalid:", err)
+		os.Exit(1)
+	}
+
+	ok := true
+
+	for _, cc := range conf.Meters {
+		ok = checkDevice("meter", cc, func() error {
+			m, err := meter.NewFromConfig(cc.Type, cc.Other)
+			if err == nil {
+				_, err = m.CurrentPower()
+			}
+			return err
+		}) && ok
+	}
+
+	for _, cc := range conf.Chargers {
+		ok = checkDevice("charger", cc, func() error {
+			c, err := charger.NewFromConfig(cc.Type, cc.Other)
+			if err == nil {
+				_, err = c.Status()
+			}
+			return err
+		}) && ok
+	}
+
+	if !ok {
+		log.FATAL.Println("config invalid: device errors")
+		os.Exit(1)
+	}
+
+	fmt.Println("config valid")
+}
+
+// checkDevice creates and queries a device and prints the result
+func checkDevice(class string, cc config.Named, check func() error) bool {
+	name := cc.Name
+	if name == "" {
+		name = "<missing name>"
+	}
+
+	if err := check(); err != nil {
+		fmt.Printf("%s %s (%s): %s\n", class, name, cc.Type, deviceError(err))
+		return false
 	}
+
+	fmt.Printf("%s %s (%s): ok\n", class, name, cc.Type)
+	return true
+}
+
+// deviceError returns the full error message, including the network address if not already contained
+func deviceError(err error) string {
+	res := err.Error()
+
+	var oe *net.OpError
+	if errors.As(err, &oe) && oe.Addr != nil && !strings.Contains(res, oe.Addr.String()) {
+		res = fmt.Sprintf("%s (%s %s)", res, oe.Net, oe.Addr)
+	}
+
+	return res
 }
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeviceError(t *testing.T) {
+	assert.Equal(t, "foo", deviceError(errors.New("foo")))
+
+	oe := &net.OpError{
+		Op:   "dial",
+		Net:  "tcp",
+		Addr: &net.TCPAddr{IP: net.IPv4(192, 0, 2, 2), Port: 502},
+		Err:  syscall.ECONNREFUSED,
+	}
+
+	// address already contained
+	assert.Equal(t, oe.Error(), deviceError(oe))
+
+	// address hidden by wrapping error
+	err := fmt.Errorf("connection failed: %w", &hiddenError{oe})
+	assert.Equal(t, "connection failed: refused (tcp 192.0.2.2:502)", deviceError(err))
+}
+
+type hiddenError struct {
+	err error
+}
+
+func (e *hiddenError) Error() string { return "refused" }
+func (e *hiddenError) Unwrap() error { return e.err }
@@ -41,6 +41,21 @@ const (
 	flagRepeat            = "repeat"
 	flagRepeatDescription = "Repeat until interrupted"
 
+	flagWrite            = "write"
+	flagWriteDescription = "Execute write operations"
+
+	flagSyntax            = "syntax"
+	flagSyntaxDescription = "Check config file syntax only, without creating devices"
+
+	flagFile            = "file"
+	flagFileDescription = "Recording file"
+
+	flagSpeed            = "speed"
+	flagSpeedDescription = "Replay speed, e.g. 60x"
+
+	flagOutput            = "output"
+	flagOutputDescription = "Output file"
+
 	flagDigits = "digits"
 	flagDelay  = "delay"
 	flagForce  = "force"
@@ -0,0 +1,14 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var modbusCmd = &cobra.Command{
+	Use:   "modbus",
+	Short: "Modbus tools",
+}
+
+func init() {
+	rootCmd.AddCommand(modbusCmd)
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var modbusScanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Scan for responding Modbus slave ids",
+	Args:  cobra.ExactArgs(0),
+	Run:   runModbusScan,
+}
+
+func init() {
+	modbusCmd.AddCommand(modbusScanCmd)
+
+	modbusScanCmd.Flags().String("uri", "", "Modbus TCP uri (host:port)")
+	modbusScanCmd.Flags().String("device", "", "Serial device, e.g. /dev/ttyUSB0")
+	modbusScanCmd.Flags().Int("baudrate", 9600, "Serial baudrate")
+	modbusScanCmd.Flags().String("comset", "8N1", "Serial communication settings")
+	modbusScanCmd.Flags().Bool("rtu", false, "Use RTU over TCP")
+	modbusScanCmd.Flags().Uint16("address", 0, "Register address")
+	modbusScanCmd.Flags().Duration("timeout", 500*time.Millisecond, "Response timeout per slave id")
+}
+
+// modbusScanResult formats a scan response, exception responses indicate a responding device
+func modbusScanResult(b []byte, err error) (string, bool) {
+	if ee := new(modbus.ExceptionError); errors.As(err, &ee) {
+		return ee.Error(), true
+	}
+	if err != nil || len(b) < 2 {
+		return "-", false
+	}
+	return fmt.Sprintf("%d (0x%04x)", binary.BigEndian.Uint16(b), binary.BigEndian.Uint16(b)), true
+}
+
+func runModbusScan(cmd *cobra.Command, args []string) {
+	util.LogLevel(viper.GetString("log"), nil)
+
+	uri, _ := cmd.Flags().GetString("uri")
+	device, _ := cmd.Flags().GetString("device")
+	baudrate, _ := cmd.Flags().GetInt("baudrate")
+	comset, _ := cmd.Flags().GetString("comset")
+	rtu, _ := cmd.Flags().GetBool("rtu")
+	address, _ := cmd.Flags().GetUint16("address")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+
+	if (uri == "") == (device == "") {
+		log.FATAL.Fatal("either uri or device required")
+	}
+
+	conn, err := modbus.NewConnection(uri, device, comset, baudrate, modbus.ProtocolFromRTU(&rtu), 1)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+	conn.Logger(log.TRACE)
+	conn.Timeout(timeout)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tHolding\tInput")
+
+	var found int
+	for id := 1; id <= 247; id++ {
+		fmt.Fprintf(os.Stderr, "\rscanning slave id %d/247", id)
+
+		holding, hok := modbusScanResult(conn.ReadHoldingRegistersWithSlave(uint8(id), address, 1))
+		input, iok := modbusScanResult(conn.ReadInputRegistersWithSlave(uint8(id), address, 1))
+
+		if hok || iok {
+			found++
+			fmt.Fprintf(tw, "%d\t%s\t%s\n", id, holding, input)
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "\rfound %d responding slave ids   \n", found)
+	tw.Flush()
+}
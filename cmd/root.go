@@ -193,10 +193,27 @@ func runRoot(cmd *cobra.Command, args []string) {
 		configureInflux(conf.Influx, site, pipe.NewDropper(append(ignoreLogs, ignoreEmpty)...).Pipe(tee.Attach()))
 	}
 
+	// setup device metrics export
+	if err == nil && conf.InfluxExport.URL != "" {
+		err = configureInfluxExport(conf.InfluxExport, site)
+	}
+
+	// setup prometheus gauges
+	if err == nil && viper.GetBool("metrics") {
+		err = configurePrometheus(site, pipe.NewDropper(append(ignoreLogs, ignoreEmpty)...).Pipe(tee.Attach()))
+	}
+
+	auth := auth.New()
+
 	// setup mqtt publisher
 	if err == nil && conf.Mqtt.Broker != "" {
+		mqttAuth := auth
+		if !conf.Mqtt.Auth {
+			mqttAuth = nil
+		}
+
 		var mqtt *server.MQTT
-		mqtt, err = server.NewMQTT(strings.Trim(conf.Mqtt.Topic, "/"), site)
+		mqtt, err = server.NewMQTT(strings.Trim(conf.Mqtt.Topic, "/"), site, mqttAuth)
 		if err == nil {
 			go mqtt.Run(site, pipe.NewDropper(append(ignoreMqtt, ignoreEmpty)...).Pipe(tee.Attach()))
 		}
@@ -218,6 +235,11 @@ func runRoot(cmd *cobra.Command, args []string) {
 		pushChan, err = configureMessengers(conf.Messaging, site.Vehicles(), valueChan, cache)
 	}
 
+	// setup charging session reports
+	if err == nil {
+		err = configureReports(conf.Notifications, conf.Tariffs.Currency)
+	}
+
 	// run shutdown functions on stop
 	var once sync.Once
 	stopC := make(chan struct{})
@@ -247,8 +269,6 @@ func runRoot(cmd *cobra.Command, args []string) {
 		os.Exit(0)
 	}()
 
-	auth := auth.New()
-
 	// show main ui
 	if err == nil {
 		httpd.RegisterSiteHandlers(site, auth, cache)
@@ -27,6 +27,7 @@ import (
 	"github.com/evcc-io/evcc/provider/javascript"
 	"github.com/evcc-io/evcc/provider/mqtt"
 	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/push/report"
 	"github.com/evcc-io/evcc/server"
 	"github.com/evcc-io/evcc/server/db"
 	"github.com/evcc-io/evcc/server/db/settings"
@@ -34,6 +35,7 @@ import (
 	"github.com/evcc-io/evcc/tariff"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/config"
+	"github.com/evcc-io/evcc/util/export/influx"
 	"github.com/evcc-io/evcc/util/locale"
 	"github.com/evcc-io/evcc/util/machine"
 	"github.com/evcc-io/evcc/util/modbus"
@@ -45,6 +47,7 @@ import (
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/libp2p/zeroconf/v2"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"golang.org/x/sync/errgroup"
@@ -71,35 +74,38 @@ var conf = globalConfig{
 var nameRE = regexp.MustCompile(`^[a-zA-Z0-9_.:-]+$`)
 
 type globalConfig struct {
-	Network      networkConfig
-	Log          string
-	SponsorToken string
-	Plant        string // telemetry plant id
-	Telemetry    bool
-	Metrics      bool
-	Profile      bool
-	Levels       map[string]string
-	Interval     time.Duration
-	Database     dbConfig
-	Mqtt         mqttConfig
-	ModbusProxy  []proxyConfig
-	Javascript   []javascriptConfig
-	Go           []goConfig
-	Influx       server.InfluxConfig
-	EEBus        map[string]interface{}
-	HEMS         config.Typed
-	Messaging    messagingConfig
-	Meters       []config.Named
-	Chargers     []config.Named
-	Vehicles     []config.Named
-	Tariffs      tariffConfig
-	Site         map[string]interface{}
-	Loadpoints   []map[string]interface{}
+	Network       networkConfig
+	Log           string
+	SponsorToken  string
+	Plant         string // telemetry plant id
+	Telemetry     bool
+	Metrics       bool
+	Profile       bool
+	Levels        map[string]string
+	Interval      time.Duration
+	Database      dbConfig
+	Mqtt          mqttConfig
+	ModbusProxy   []proxyConfig
+	Javascript    []javascriptConfig
+	Go            []goConfig
+	Influx        server.InfluxConfig
+	InfluxExport  influx.Config
+	EEBus         map[string]interface{}
+	HEMS          config.Typed
+	Messaging     messagingConfig
+	Notifications notificationsConfig
+	Meters        []config.Named
+	Chargers      []config.Named
+	Vehicles      []config.Named
+	Tariffs       tariffConfig
+	Site          map[string]interface{}
+	Loadpoints    []map[string]interface{}
 }
 
 type mqttConfig struct {
 	mqtt.Config `mapstructure:",squash"`
 	Topic       string
+	Auth        bool // require api token for setter commands
 }
 
 type javascriptConfig struct {
@@ -128,12 +134,17 @@ type messagingConfig struct {
 	Services []config.Typed
 }
 
+type notificationsConfig struct {
+	Email *report.Config // charging session reports
+}
+
 type tariffConfig struct {
-	Currency string
-	Grid     config.Typed
-	FeedIn   config.Typed
-	Co2      config.Typed
-	Planner  config.Typed
+	Currency  string
+	Grid      config.Typed
+	FeedIn    config.Typed
+	Co2       config.Typed
+	Co2PerKWh float64
+	Planner   config.Typed
 }
 
 type networkConfig struct {
@@ -499,6 +510,56 @@ func configureInflux(conf server.InfluxConfig, site site.API, in <-chan util.Par
 	go influx.Run(site, in)
 }
 
+// configureInfluxExport configures the device metrics export
+func configureInfluxExport(conf influx.Config, site *core.Site) error {
+	exporter, err := influx.NewFromConfig(conf)
+	if err != nil {
+		return fmt.Errorf("influx export: %w", err)
+	}
+
+	site.SetExporter(exporter)
+
+	// write remaining metrics
+	shutdown.Register(func() {
+		if err := exporter.Flush(); err != nil {
+			log.ERROR.Printf("influx export: %v", err)
+		}
+	})
+
+	return nil
+}
+
+// setup prometheus
+func configurePrometheus(site *core.Site, in <-chan util.Param) error {
+	var loadpoints []server.PrometheusLoadpoint
+
+	for _, lpI := range site.Loadpoints() {
+		lp := lpI.(*core.Loadpoint)
+
+		res := server.PrometheusLoadpoint{
+			Title:   lp.Title(),
+			Charger: lp.ChargerRef,
+		}
+
+		if dev, err := config.Chargers().ByName(lp.ChargerRef); err == nil {
+			cc := dev.Config()
+			res.Type = cc.Type
+			if template, ok := cc.Other["template"].(string); ok && cc.Type == "template" {
+				res.Type = template
+			}
+		}
+
+		loadpoints = append(loadpoints, res)
+	}
+
+	p, err := server.NewPrometheus(prometheus.DefaultRegisterer, loadpoints)
+	if err == nil {
+		go p.Run(in)
+	}
+
+	return err
+}
+
 // setup mqtt
 func configureMQTT(conf mqttConfig) error {
 	log := util.NewLogger("mqtt")
@@ -602,6 +663,26 @@ func configureMessengers(conf messagingConfig, vehicles push.Vehicles, valueChan
 	return messageChan, nil
 }
 
+// setup charging session reports
+func configureReports(conf notificationsConfig, currency string) error {
+	if conf.Email == nil {
+		return nil
+	}
+
+	if currency == "" {
+		currency = "EUR"
+	}
+
+	reporter, err := report.New(*conf.Email, db.Instance, currency)
+	if err != nil {
+		return fmt.Errorf("failed configuring email reports: %w", err)
+	}
+
+	go reporter.Run()
+
+	return nil
+}
+
 func configureTariff(name string, conf config.Typed, t *api.Tariff, wg *sync.WaitGroup) {
 	defer wg.Done()
 
@@ -620,13 +701,18 @@ func configureTariff(name string, conf config.Typed, t *api.Tariff, wg *sync.Wai
 
 func configureTariffs(conf tariffConfig) (*tariff.Tariffs, error) {
 	tariffs := tariff.Tariffs{
-		Currency: currency.EUR,
+		Currency:  currency.EUR,
+		Co2PerKWh: conf.Co2PerKWh,
 	}
 
 	if conf.Currency != "" {
 		tariffs.Currency = currency.MustParseISO(conf.Currency)
 	}
 
+	if conf.Co2PerKWh < 0 {
+		return nil, fmt.Errorf("invalid co2PerKWh: %.0fg", conf.Co2PerKWh)
+	}
+
 	var wg sync.WaitGroup
 	wg.Add(4)
 
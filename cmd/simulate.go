@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/simulation"
+	"github.com/spf13/cobra"
+)
+
+// simulateCmd represents the simulate command
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Replay recorded readings through the site logic",
+	Long: `Replay recorded readings through the site logic.
+Recorded values are injected into devices using the simulation plugin (source: simulation, key: <column>).
+Published site and loadpoint state changes are written to the output file.`,
+	Run: runSimulate,
+}
+
+func init() {
+	rootCmd.AddCommand(simulateCmd)
+	simulateCmd.Flags().StringP(flagFile, "f", "", flagFileDescription)
+	simulateCmd.Flags().StringP(flagSpeed, "s", "1x", flagSpeedDescription)
+	simulateCmd.Flags().StringP(flagOutput, "o", "simulation.csv", flagOutputDescription)
+	_ = simulateCmd.MarkFlagRequired(flagFile)
+}
+
+// parseSpeed parses the replay speed, e.g. 60x
+func parseSpeed(s string) (float64, error) {
+	speed, err := strconv.ParseFloat(strings.TrimSuffix(strings.ToLower(s), "x"), 64)
+	if err == nil && speed <= 0 {
+		err = fmt.Errorf("invalid speed: %s", s)
+	}
+	return speed, err
+}
+
+// simulationWriter writes published state changes at the current simulation time
+type simulationWriter struct {
+	mu   sync.Mutex
+	w    *csv.Writer
+	ts   time.Time
+	last map[string]string
+}
+
+func (sw *simulationWriter) setTime(ts time.Time) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	sw.ts = ts
+}
+
+func (sw *simulationWriter) write(p util.Param) error {
+	val, ok := simulationValue(p.Val)
+	if !ok {
+		return nil
+	}
+
+	var lp string
+	if p.Loadpoint != nil {
+		lp = strconv.Itoa(*p.Loadpoint + 1)
+	}
+
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	key := lp + "/" + p.Key
+	if last, ok := sw.last[key]; ok && last == val {
+		return nil
+	}
+	sw.last[key] = val
+
+	return sw.w.Write([]string{sw.ts.Format(time.RFC3339), lp, p.Key, val})
+}
+
+// simulationValue formats scalar values, other values are skipped
+func simulationValue(v any) (string, bool) {
+	if v == nil {
+		return "", true
+	}
+
+	if s, ok := v.(fmt.Stringer); ok {
+		return s.String(), true
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return "", true
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int64, reflect.Float64, reflect.String:
+		return fmt.Sprint(rv.Interface()), true
+	}
+
+	return "", false
+}
+
+func runSimulate(cmd *cobra.Command, args []string) {
+	// load config
+	if err := loadConfigFile(&conf); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	speed, err := parseSpeed(cmd.Flag(flagSpeed).Value.String())
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	f, err := os.Open(cmd.Flag(flagFile).Value.String())
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	records, err := simulation.ReadRecords(f)
+	f.Close()
+	if err != nil {
+		log.FATAL.Fatalf("reading recording: %v", err)
+	}
+
+	if len(records) == 0 {
+		log.FATAL.Fatal("empty recording")
+	}
+
+	// setup environment
+	if err := configureEnvironment(cmd, conf); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	// inject initial values before creating devices
+	for key, val := range records[0].Values {
+		simulation.Set(key, val)
+	}
+
+	site, err := configureSiteAndLoadpoints(conf)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	out, err := os.Create(cmd.Flag(flagOutput).Value.String())
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+	defer out.Close()
+
+	sw := &simulationWriter{
+		w:    csv.NewWriter(out),
+		ts:   records[0].Time,
+		last: make(map[string]string),
+	}
+
+	if err := sw.w.Write([]string{"time", "loadpoint", "key", "value"}); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	valueChan := make(chan util.Param, 64)
+	pushChan := make(chan push.Event, 16)
+	go func() {
+		for p := range valueChan {
+			if err := sw.write(p); err != nil {
+				log.ERROR.Println("output:", err)
+			}
+		}
+	}()
+
+	go func() {
+		for range pushChan {
+		}
+	}()
+
+	site.Prepare(valueChan, pushChan)
+
+	stopC := make(chan struct{})
+	siteDoneC := make(chan struct{})
+
+	go func() {
+		site.Run(stopC, time.Duration(float64(conf.Interval)/speed))
+		close(siteDoneC)
+	}()
+
+	log.INFO.Printf("replaying %d records from %s at %gx speed", len(records), records[0].Time.Format(time.RFC3339), speed)
+
+	simulation.Replay(records, speed, nil, func(rec simulation.Record) {
+		sw.setTime(rec.Time)
+	})
+
+	close(stopC)
+	<-siteDoneC
+
+	// allow pending state changes to be written
+	time.Sleep(100 * time.Millisecond)
+
+	sw.mu.Lock()
+	sw.w.Flush()
+	err = sw.w.Error()
+	sw.mu.Unlock()
+
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	log.INFO.Println("simulation finished:", cmd.Flag(flagOutput).Value.String())
+}
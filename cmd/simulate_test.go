@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpeed(t *testing.T) {
+	speed, err := parseSpeed("60x")
+	require.NoError(t, err)
+	assert.Equal(t, 60.0, speed)
+
+	speed, err = parseSpeed("1.5")
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, speed)
+
+	_, err = parseSpeed("0x")
+	assert.Error(t, err)
+}
+
+func TestSimulationWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	sw := &simulationWriter{
+		w:    csv.NewWriter(&buf),
+		ts:   time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+		last: make(map[string]string),
+	}
+
+	lp := 0
+	f := func(f float64) *float64 { return &f }
+
+	for _, p := range []util.Param{
+		{Key: "gridPower", Val: -1500.0},
+		{Key: "gridPower", Val: -1500.0}, // unchanged
+		{Loadpoint: &lp, Key: "mode", Val: api.ModePV},
+		{Loadpoint: &lp, Key: "sessionPrice", Val: f(1.5)},
+		{Loadpoint: &lp, Key: "sessionCo2", Val: (*float64)(nil)},
+		{Key: "statistics", Val: map[string]float64{}}, // skipped
+	} {
+		require.NoError(t, sw.write(p))
+	}
+
+	sw.w.Flush()
+	assert.Equal(t, `2024-06-01T12:00:00Z,,gridPower,-1500
+2024-06-01T12:00:00Z,1,mode,pv
+2024-06-01T12:00:00Z,1,sessionPrice,1.5
+2024-06-01T12:00:00Z,1,sessionCo2,
+`, buf.String())
+}
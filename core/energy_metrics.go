@@ -94,6 +94,14 @@ func (em *EnergyMetrics) PricePerKWh() *float64 {
 	return &price
 }
 
+// Co2 returns the total co2 emissions in gCO2eq
+func (em *EnergyMetrics) Co2() *float64 {
+	if em.totalKWh == 0 || em.co2 == nil {
+		return nil
+	}
+	return em.co2
+}
+
 // Co2PerKWh returns the average co2 emissions per kWh
 func (em *EnergyMetrics) Co2PerKWh() *float64 {
 	if em.totalKWh == 0 || em.co2 == nil {
@@ -109,5 +117,6 @@ func (em *EnergyMetrics) Publish(prefix string, p publisher) {
 	p.publish(prefix+"SolarPercentage", em.SolarPercentage())
 	p.publish(prefix+"PricePerKWh", em.PricePerKWh())
 	p.publish(prefix+"Price", em.Price())
+	p.publish(prefix+"Co2", em.Co2())
 	p.publish(prefix+"Co2PerKWh", em.Co2PerKWh())
 }
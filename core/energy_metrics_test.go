@@ -142,6 +142,9 @@ func TestEnergyMetrics(t *testing.T) {
 		if !isEqualFloat64(co2PerKWh, tc.co2PerKWh) {
 			t.Errorf("%s: Co2PerKWh was incorrect, got: %v, want: %v.", tc.title, *co2PerKWh, *tc.co2PerKWh)
 		}
+		if co2 := s.Co2(); co2PerKWh != nil && (co2 == nil || *co2 != *co2PerKWh*tc.totalWh/1e3) {
+			t.Errorf("%s: Co2 was incorrect, got: %v, want: %v.", tc.title, co2, *co2PerKWh*tc.totalWh/1e3)
+		}
 	}
 
 	// reset
@@ -149,7 +152,7 @@ func TestEnergyMetrics(t *testing.T) {
 	s.SetEnvironment(1, f(1), f(1))
 	s.Update(1)
 	s.Reset()
-	if s.TotalWh() != 0 || s.SolarPercentage() != 0 || s.Co2PerKWh() != nil || s.Price() != nil || s.PricePerKWh() != nil {
+	if s.TotalWh() != 0 || s.SolarPercentage() != 0 || s.Co2PerKWh() != nil || s.Co2() != nil || s.Price() != nil || s.PricePerKWh() != nil {
 		t.Errorf("Metrics not properly reset %+v", s)
 	}
 }
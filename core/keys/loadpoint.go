@@ -22,11 +22,16 @@ const (
 	ChargerPhysicalPhases = "chargerPhysicalPhases" // charger phases
 	ChargerPhases1p3p     = "chargerPhases1p3p"     // phase switcher (1p3p chargers)
 
+	DryRun = "dryRun" // charger writes are logged only
+
 	// loadpoint status
 	Enabled   = "enabled"   // loadpoint enabled
 	Connected = "connected" // connected
 	Charging  = "charging"  // charging
 
+	TemperatureDerating = "temperatureDerating" // charge current derating due to ambient temperature (%)
+	GridAllocation      = "gridAllocation"      // charge power allocated on insufficient grid capacity (W)
+
 	// smart charging
 	SmartCostActive = "smartCostActive" // smart cost active
 	SmartCostLimit  = "smartCostLimit"  // smart cost limit
@@ -52,6 +57,9 @@ const (
 	ConnectedDuration       = "connectedDuration"       // connected duration
 	ChargeRemainingDuration = "chargeRemainingDuration" // charge remaining duration
 	ChargeRemainingEnergy   = "chargeRemainingEnergy"   // charge remaining energy
+	LimitEnergyRemaining    = "limitEnergyRemaining"    // remaining energy until session limit
+
+	MinChargeDurationRemaining = "minChargeDurationRemaining" // remaining minimum charge duration
 
 	// plan
 	PlanTime           = "planTime"           // charge plan finish time goal
@@ -65,10 +73,15 @@ const (
 	RemoteDisabled       = "remoteDisabled"       // remote disabled
 	RemoteDisabledSource = "remoteDisabledSource" // remote disabled source
 
+	// tariff
+	TariffCeilingPaused = "tariffCeilingPaused" // charging paused while grid price exceeds ceiling
+
 	// vehicle
 	VehicleName            = "vehicleName"            // vehicle name
 	VehicleIdentity        = "vehicleIdentity"        // vehicle identity
 	VehicleDetectionActive = "vehicleDetectionActive" // vehicle detection active
+	VehicleGuest           = "vehicleGuest"           // unidentified vehicle charging in guest mode
+	VehicleOffline         = "vehicleOffline"         // vehicle api unreachable, current limited
 	VehicleOdometer        = "vehicleOdometer"        // vehicle odometer
 	VehicleRange           = "vehicleRange"           // vehicle range
 	VehicleSoc             = "vehicleSoc"             // vehicle soc
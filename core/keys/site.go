@@ -4,19 +4,27 @@ const (
 	Aux                   = "aux"
 	AuxPower              = "auxPower"
 	Currency              = "currency"
+	DemandAverage         = "demandAverage"
+	DemandPrevious        = "demandPrevious"
+	DemandProjected       = "demandProjected"
+	DemandWindowProgress  = "demandWindowProgress"
 	GreenShareHome        = "greenShareHome"
 	GreenShareLoadpoints  = "greenShareLoadpoints"
 	GridConfigured        = "gridConfigured"
 	GridCurrents          = "gridCurrents"
 	GridEnergy            = "gridEnergy"
+	GridFrequency         = "gridFrequency"
 	GridPower             = "gridPower"
 	GridPowers            = "gridPowers"
+	GridProtectionFault   = "gridProtectionFault"
 	HomePower             = "homePower"
+	Maintenance           = "maintenance"
 	PrioritySoc           = "prioritySoc"
 	Pv                    = "pv"
 	PvEnergy              = "pvEnergy"
 	PvPower               = "pvPower"
 	ResidualPower         = "residualPower"
+	Temperature           = "temperature"
 	SiteTitle             = "siteTitle"
 	SmartCostType         = "smartCostType"
 	Statistics            = "statistics"
@@ -40,6 +48,7 @@ const (
 	// battery settings
 	BatteryCapacity         = "batteryCapacity"
 	BatteryDischargeControl = "batteryDischargeControl"
+	BatteryProtection       = "batteryProtection"
 	BufferSoc               = "bufferSoc"
 	BufferStartSoc          = "bufferStartSoc"
 
@@ -38,6 +38,7 @@ const (
 	evVehicleDisconnect   = "disconnect" // vehicle disconnected
 	evVehicleSoc          = "soc"        // vehicle soc progress
 	evVehicleUnidentified = "guest"      // vehicle unidentified
+	evChargerFault        = "fault"      // charger fault not recovered
 
 	pvTimer   = "pv"
 	pvEnable  = "enable"
@@ -49,7 +50,7 @@ const (
 
 	timerInactive = "inactive"
 
-	minActiveCurrent = 1.0 // minimum current at which a phase is treated as active
+	minActiveCurrent = 1.0 // default minimum current at which a phase is treated as active
 	minActiveVoltage = 207 // minimum voltage at which a phase is treated as active
 
 	chargerSwitchDuration = 60 * time.Second // allow out of sync during this timespan
@@ -71,6 +72,12 @@ type SocConfig struct {
 	Estimate *bool      `mapstructure:"estimate"`
 }
 
+// StartupRampConfig limits the charge current at session start
+type StartupRampConfig struct {
+	InitialCurrent float64       `mapstructure:"initialCurrent"` // Charge current at session start, defaults to min current
+	RampTime       time.Duration `mapstructure:"rampTime"`       // Duration to apply the initial current before stepping to target
+}
+
 // Poll modes
 const (
 	pollCharging  = "charging"
@@ -112,6 +119,41 @@ type Loadpoint struct {
 	MeterRef        string `mapstructure:"meter"`    // Charge meter reference
 	Soc             SocConfig
 	Enable, Disable ThresholdConfig
+	PhaseMapping    []int `mapstructure:"phaseMapping"` // Grid phase each charger phase is wired to, e.g. [3, 2, 1] for L1/L3 swapped
+
+	PreferCheapWindows bool    `mapstructure:"preferCheapWindows"` // Plan a single contiguous charging window of lowest cost
+	MinPhaseCurrent    float64 `mapstructure:"minPhaseCurrent"`    // Minimum current at which a phase is detected as active
+
+	FaultRecovery         string        `mapstructure:"faultRecovery"`         // Charger fault recovery mode (auto)
+	FaultRecoveryDelay    time.Duration `mapstructure:"faultRecoveryDelay"`    // Delay before attempting fault recovery
+	FaultRecoveryAttempts int           `mapstructure:"faultRecoveryAttempts"` // Maximum number of fault recovery attempts
+
+	CurrentRounding string  `mapstructure:"currentRounding"` // Rounding of charge current to full amps (floor, ceil, round, nearest-even)
+	CurrentDeadband float64 `mapstructure:"currentDeadband"` // Minimum charge current change to update the charger
+	CurrentStepSize float64 `mapstructure:"currentStepSize"` // Charge current step size of chargers with fractional current support
+
+	EnergyLimit           float64 `mapstructure:"energyLimit"`           // Default session energy limit (kWh)
+	EnergyLimitPersistent bool    `mapstructure:"energyLimitPersistent"` // Keep session energy limit when vehicle disconnects
+
+	StartupRamp  StartupRampConfig `mapstructure:"startupRamp"`
+	PollInterval time.Duration     `mapstructure:"pollInterval"` // Charger poll interval, defaults to round-robin at site interval
+	StableAfter  time.Duration     `mapstructure:"stableAfter"`  // Adaptive polling: back off to poll interval within this duration after status change
+
+	TariffMaxCurrent []TariffCurrentConfig `mapstructure:"tariffMaxCurrent"` // Max current per tariff zone
+
+	TariffCeiling           float64 `mapstructure:"tariffCeiling"`           // Pause charging while grid price exceeds this value
+	TariffCeilingHysteresis float64 `mapstructure:"tariffCeilingHysteresis"` // Resume charging below ceiling - hysteresis
+
+	MinChargeDuration time.Duration `mapstructure:"minChargeDuration"` // Minimum duration before PV mode disables a started charging session
+
+	SurplusHysteresis float64 `mapstructure:"surplusHysteresis"` // PV mode: surplus above/below min power required to enable/disable charging (W)
+
+	DryRun bool `mapstructure:"dryRun"` // Log charger writes instead of executing them
+
+	GuestMode GuestModeConfig `mapstructure:"guestMode"` // Charge unidentified vehicles at reduced current
+
+	VehicleOfflineCurrent float64       `mapstructure:"vehicleOfflineCurrent"` // Max current while the vehicle api is unreachable
+	VehicleOfflineAfter   time.Duration `mapstructure:"vehicleOfflineAfter"`   // Duration after which an unreachable vehicle api limits the current
 
 	// TODO deprecated
 	GuardDuration_    time.Duration `mapstructure:"guardduration"` // charger enable/disable minimum holding time
@@ -157,14 +199,24 @@ type Loadpoint struct {
 	planActive  bool      // charge plan exists and has a currently active slot
 
 	// cached state
-	status         api.ChargeStatus       // Charger status
-	remoteDemand   loadpoint.RemoteDemand // External status demand
-	chargePower    float64                // Charging power
-	chargeCurrents []float64              // Phase currents
-	connectedTime  time.Time              // Time when vehicle was connected
-	pvTimer        time.Time              // PV enabled/disable timer
-	phaseTimer     time.Time              // 1p3p switch timer
-	wakeUpTimer    *Timer                 // Vehicle wake-up timeout
+	status              api.ChargeStatus       // Charger status
+	statusUpdated       time.Time              // Charger status change timestamp for adaptive polling
+	pollResetC          chan struct{}          // Restarts adaptive polling on status change
+	remoteDemand        loadpoint.RemoteDemand // External status demand
+	chargePower         float64                // Charging power
+	chargeCurrents      []float64              // Phase currents
+	connectedTime       time.Time              // Time when vehicle was connected
+	pvTimer             time.Time              // PV enabled/disable timer
+	phaseTimer          time.Time              // 1p3p switch timer
+	wakeUpTimer         *Timer                 // Vehicle wake-up timeout
+	faultTimer          time.Time              // Charger fault detected or last recovery attempt
+	faultAttempts       int                    // Charger fault recovery attempts
+	maintenance         bool                   // Site maintenance window active
+	frequencyPause      bool                   // Charging paused due to grid frequency deviation
+	gridProtection      bool                   // Charging stopped due to grid protection relay fault
+	temperatureDerating float64                // Max current reduction due to ambient temperature, 1 stops charging
+	gridAllocation      float64                // Max charge power allocated by the site on insufficient grid capacity
+	gridAllocated       bool                   // Grid allocation applies
 
 	// charge progress
 	vehicleSoc              float64        // Vehicle Soc
@@ -174,6 +226,17 @@ type Loadpoint struct {
 	chargeRemainingEnergy   float64        // Remaining charge energy in Wh
 	progress                *Progress      // Step-wise progress indicator
 
+	startupRampPending bool      // Startup ramp applies to next charger enable
+	startupRampStart   time.Time // Start of the active startup ramp
+	tariffZone         string    // Active tariff zone
+	tariffCeilingPause bool      // Charging paused due to grid price above ceiling
+	chargeStarted      time.Time // Start of the current charging segment
+	vehicleOBCLimit    float64   // Vehicle on-board charger current limit
+	guest              bool      // Unidentified vehicle charging in guest mode
+	vehicleUnreachable time.Time // Vehicle api unreachable since
+	vehicleOffline     bool      // Vehicle api unreachable for longer than VehicleOfflineAfter
+	vehicleSocLimit    int64     // Vehicle soc limit written to the charger
+
 	// session log
 	db      *session.DB
 	session *session.Session
@@ -202,6 +265,33 @@ func NewLoadpointFromConfig(log *util.Logger, settings *Settings, other map[stri
 		lp.Soc.Poll.Mode = pollCharging
 	}
 
+	// set fault recovery mode
+	switch lp.FaultRecovery = strings.ToLower(lp.FaultRecovery); lp.FaultRecovery {
+	case "", faultRecoveryAuto:
+	default:
+		lp.log.WARN.Printf("invalid fault recovery mode: %s", lp.FaultRecovery)
+		lp.FaultRecovery = ""
+	}
+
+	// set current rounding mode
+	switch lp.CurrentRounding = strings.ToLower(lp.CurrentRounding); lp.CurrentRounding {
+	case "", roundingFloor, roundingCeil, roundingRound, roundingNearestEven:
+	default:
+		lp.log.WARN.Printf("invalid current rounding: %s", lp.CurrentRounding)
+		lp.CurrentRounding = ""
+	}
+
+	if lp.CurrentStepSize < 0 {
+		lp.log.WARN.Printf("invalid current step size: %.3gA", lp.CurrentStepSize)
+		lp.CurrentStepSize = 0
+	}
+
+	lp.validateTariffMaxCurrent()
+	lp.validateTariffCeiling()
+	lp.validateGuestMode()
+	lp.validateVehicleOffline()
+	lp.validateAdaptivePoll()
+
 	if lp.MeterRef != "" {
 		dev, err := config.Meters().ByName(lp.MeterRef)
 		if err != nil {
@@ -229,6 +319,14 @@ func NewLoadpointFromConfig(log *util.Logger, settings *Settings, other map[stri
 	lp.charger = dev.Instance()
 	lp.configureChargerType(lp.charger)
 
+	// validate phase mapping
+	if err := validatePhaseMapping(lp.PhaseMapping, lp.hasPhaseSwitching()); err != nil {
+		return nil, err
+	}
+	if !isStandardPhaseMapping(lp.PhaseMapping) {
+		lp.log.WARN.Printf("non-standard charger wiring configured: charger phases L1/L2/L3 connected to grid phases L%d/L%d/L%d", lp.PhaseMapping[0], lp.PhaseMapping[1], lp.PhaseMapping[2])
+	}
+
 	// phase switching defaults based on charger capabilities
 	if !lp.hasPhaseSwitching() {
 		lp.configuredPhases = 3
@@ -262,6 +360,11 @@ func NewLoadpointFromConfig(log *util.Logger, settings *Settings, other map[stri
 		lp.log.WARN.Printf("PV mode enable threshold %.0fW > 0 will start PV charging on grid power consumption. Did you mean -%.0f?", lp.Enable.Threshold, lp.Enable.Threshold)
 	}
 
+	if lp.SurplusHysteresis < 0 {
+		lp.log.WARN.Printf("invalid surplus hysteresis: %.0fW", lp.SurplusHysteresis)
+		lp.SurplusHysteresis = 0
+	}
+
 	// choose sane default if mode is not set
 	if lp.mode = lp.Mode_; lp.mode == "" {
 		lp.mode = api.ModeOff
@@ -290,12 +393,14 @@ func NewLoadpoint(log *util.Logger, settings *Settings) *Loadpoint {
 				Mode:     pollCharging,
 			},
 		},
-		Enable:        ThresholdConfig{Delay: time.Minute, Threshold: 0},     // t, W
-		Disable:       ThresholdConfig{Delay: 3 * time.Minute, Threshold: 0}, // t, W
-		sessionEnergy: NewEnergyMetrics(),
-		progress:      NewProgress(0, 10),     // soc progress indicator
-		coordinator:   coordinator.NewDummy(), // dummy vehicle coordinator
-		tasks:         util.NewQueue[Task](),  // task queue
+		Enable:          ThresholdConfig{Delay: time.Minute, Threshold: 0},     // t, W
+		Disable:         ThresholdConfig{Delay: 3 * time.Minute, Threshold: 0}, // t, W
+		CurrentStepSize: 0.1,                                                   // A
+		sessionEnergy:   NewEnergyMetrics(),
+		progress:        NewProgress(0, 10),     // soc progress indicator
+		coordinator:     coordinator.NewDummy(), // dummy vehicle coordinator
+		tasks:           util.NewQueue[Task](),  // task queue
+		pollResetC:      make(chan struct{}, 1),
 	}
 
 	return lp
@@ -324,6 +429,8 @@ func (lp *Loadpoint) restoreSettings() {
 	}
 	if v, err := lp.settings.Float(keys.LimitEnergy); err == nil && v > 0 {
 		lp.setLimitEnergy(v)
+	} else if lp.EnergyLimit > 0 {
+		lp.setLimitEnergy(lp.EnergyLimit)
 	}
 	if v, err := lp.settings.Float(keys.SmartCostLimit); err == nil {
 		lp.SetSmartCostLimit(v)
@@ -415,6 +522,8 @@ func (lp *Loadpoint) evChargeStartHandler() {
 	lp.log.INFO.Println("start charging ->")
 	lp.pushEvent(evChargeStart)
 
+	lp.chargeStarted = lp.clock.Now()
+
 	lp.stopWakeUpTimer()
 
 	// soc update reset
@@ -432,6 +541,8 @@ func (lp *Loadpoint) evChargeStartHandler() {
 func (lp *Loadpoint) evChargeStopHandler() {
 	lp.log.INFO.Println("stop charging <-")
 	lp.pushEvent(evChargeStop)
+
+	lp.chargeStarted = time.Time{}
 	if lp.enabled {
 		lp.startWakeUpTimer()
 	}
@@ -462,6 +573,10 @@ func (lp *Loadpoint) evVehicleConnectHandler() {
 	lp.connectedTime = lp.clock.Now()
 	lp.publish(keys.ConnectedDuration, time.Duration(0))
 
+	// startup ramp
+	lp.startupRampPending = true
+	lp.startupRampStart = time.Time{}
+
 	// soc update reset
 	lp.socUpdated = time.Time{}
 
@@ -515,7 +630,9 @@ func (lp *Loadpoint) evVehicleDisconnectHandler() {
 
 	// reset session
 	lp.SetLimitSoc(0)
-	lp.SetLimitEnergy(0)
+	if !lp.EnergyLimitPersistent {
+		lp.SetLimitEnergy(lp.EnergyLimit)
+	}
 
 	// mark plan slot as inactive
 	// this will force a deletion of an outdated plan once plan time is expired in GetPlan()
@@ -634,6 +751,11 @@ func (lp *Loadpoint) Prepare(uiChan chan<- util.Param, pushChan chan<- push.Even
 	lp.publish(keys.PlanEnergy, lp.planEnergy)
 	lp.publish(keys.LimitSoc, lp.limitSoc)
 	lp.publish(keys.LimitEnergy, lp.limitEnergy)
+	lp.publish(keys.DryRun, lp.DryRun)
+
+	if lp.DryRun {
+		lp.log.WARN.Println("dry-run mode: charger writes are logged but not executed")
+	}
 
 	// read initial charger state to prevent immediately disabling charger
 	if enabled, err := lp.charger.Enabled(); err == nil {
@@ -649,6 +771,13 @@ func (lp *Loadpoint) Prepare(uiChan chan<- util.Param, pushChan chan<- push.Even
 	if ctrl, ok := lp.charger.(loadpoint.Controller); ok {
 		ctrl.LoadpointControl(lp)
 	}
+
+	// react to charger state changes without waiting for the next cycle
+	if es, ok := lp.charger.(api.EventSource); ok {
+		if events := es.Events(); events != nil {
+			go lp.handleChargerEvents(events)
+		}
+	}
 }
 
 func (lp *Loadpoint) setAndPublishEnabled(enabled bool) {
@@ -666,6 +795,11 @@ func (lp *Loadpoint) syncCharger() error {
 		return fmt.Errorf("charger enabled: %w", err)
 	}
 
+	// charger does not follow the loadpoint in dry-run mode
+	if lp.DryRun {
+		return nil
+	}
+
 	shouldBeConsistent := lp.shouldBeConsistent()
 
 	if shouldBeConsistent {
@@ -682,7 +816,7 @@ func (lp *Loadpoint) syncCharger() error {
 		enabled = true
 
 		if shouldBeConsistent {
-			if err := lp.charger.Enable(true); err != nil { // also enable charger to correct internal state
+			if err := lp.chargerEnable(true); err != nil { // also enable charger to correct internal state
 				return fmt.Errorf("charger enable: %w", err)
 			}
 
@@ -726,7 +860,7 @@ func (lp *Loadpoint) syncCharger() error {
 	case !enabled && !lp.phaseSwitchCompleted():
 		// some chargers (i.E. Easee in some configurations) disable themselves to be able to switch phases
 		// -> enable charger
-		if err := lp.charger.Enable(true); err != nil {
+		if err := lp.chargerEnable(true); err != nil {
 			return fmt.Errorf("charger enable: %w", err)
 		}
 
@@ -740,21 +874,11 @@ func (lp *Loadpoint) syncCharger() error {
 
 // setLimit applies charger current limits and enables/disables accordingly
 func (lp *Loadpoint) setLimit(chargeCurrent float64) error {
-	// full amps only?
-	if _, ok := lp.charger.(api.ChargerEx); !ok || lp.vehicleHasFeature(api.CoarseCurrent) {
-		chargeCurrent = math.Trunc(chargeCurrent)
-	}
+	chargeCurrent = lp.roundCurrent(lp.startupRampCurrent(chargeCurrent))
 
 	// set current
-	if chargeCurrent != lp.chargeCurrent && chargeCurrent >= lp.effectiveMinCurrent() {
-		var err error
-		if charger, ok := lp.charger.(api.ChargerEx); ok {
-			err = charger.MaxCurrentMillis(chargeCurrent)
-		} else {
-			err = lp.charger.MaxCurrent(int64(chargeCurrent))
-		}
-
-		if err != nil {
+	if chargeCurrent != lp.chargeCurrent && chargeCurrent >= lp.effectiveMinCurrent() && !lp.withinDeadband(chargeCurrent) {
+		if err := lp.chargerMaxCurrent(chargeCurrent); err != nil {
 			v := lp.GetVehicle()
 			if vv, ok := v.(api.Resurrector); ok && errors.Is(err, api.ErrAsleep) {
 				// https://github.com/evcc-io/evcc/issues/8254
@@ -765,7 +889,7 @@ func (lp *Loadpoint) setLimit(chargeCurrent float64) error {
 				}
 			}
 
-			return fmt.Errorf("max charge current %.3gA: %w", chargeCurrent, err)
+			return fmt.Errorf("max charge current %.3gA: %w", chargeCurrent, lp.chargerError(err))
 		}
 
 		lp.log.DEBUG.Printf("max charge current: %.3gA", chargeCurrent)
@@ -775,7 +899,7 @@ func (lp *Loadpoint) setLimit(chargeCurrent float64) error {
 
 	// set enabled/disabled
 	if enabled := chargeCurrent >= lp.effectiveMinCurrent(); enabled != lp.enabled {
-		if err := lp.charger.Enable(enabled); err != nil {
+		if err := lp.chargerEnable(enabled); err != nil {
 			v := lp.GetVehicle()
 			if vv, ok := v.(api.Resurrector); enabled && ok && errors.Is(err, api.ErrAsleep) {
 				// https://github.com/evcc-io/evcc/issues/8254
@@ -786,7 +910,7 @@ func (lp *Loadpoint) setLimit(chargeCurrent float64) error {
 				}
 			}
 
-			return fmt.Errorf("charger %s: %w", status[enabled], err)
+			return fmt.Errorf("charger %s: %w", status[enabled], lp.chargerError(err))
 		}
 
 		lp.setAndPublishEnabled(enabled)
@@ -927,13 +1051,14 @@ func statusEvents(prevStatus, status api.ChargeStatus) []string {
 func (lp *Loadpoint) updateChargerStatus() error {
 	status, err := lp.charger.Status()
 	if err != nil {
-		return fmt.Errorf("charger status: %w", err)
+		return fmt.Errorf("charger status: %w", lp.chargerError(err))
 	}
 
 	lp.log.DEBUG.Printf("charger status: %s", status)
 
 	if prevStatus := lp.GetStatus(); status != prevStatus {
 		lp.setStatus(status)
+		lp.statusChanged()
 
 		for _, ev := range statusEvents(prevStatus, status) {
 			lp.bus.Publish(ev)
@@ -1030,14 +1155,13 @@ func (lp *Loadpoint) scalePhasesIfAvailable(phases int) error {
 // scalePhases adjusts the number of active phases and returns the appropriate charging current.
 // Returns api.ErrNotAvailable if api.PhaseSwitcher is not available.
 func (lp *Loadpoint) scalePhases(phases int) error {
-	cp, ok := lp.charger.(api.PhaseSwitcher)
-	if !ok {
+	if _, ok := lp.charger.(api.PhaseSwitcher); !ok {
 		panic("charger does not implement api.PhaseSwitcher")
 	}
 
 	if lp.GetPhases() != phases {
 		// switch phases
-		if err := cp.Phases1p3p(phases); err != nil {
+		if err := lp.chargerPhases1p3p(phases); err != nil {
 			return fmt.Errorf("switch phases: %w", err)
 		}
 
@@ -1199,7 +1323,17 @@ func (lp *Loadpoint) pvMaxCurrent(mode api.ChargeMode, sitePower float64, batter
 		return minCurrent
 	}
 
+	// surplus hysteresis around min power
+	hysteresisCurrent := powerToCurrent(lp.SurplusHysteresis, activePhases)
+
 	if mode == api.ModePV && lp.enabled && targetCurrent < minCurrent {
+		// keep enabled while surplus is within hysteresis
+		if disableCurrent := max(minCurrent-hysteresisCurrent, 0); targetCurrent >= disableCurrent {
+			lp.log.DEBUG.Printf("pv charge current %.3gA >= %.3gA surplus hysteresis", targetCurrent, disableCurrent)
+			lp.resetPVTimer("disable")
+			return minCurrent
+		}
+
 		projectedSitePower := sitePower
 		if !lp.phaseTimer.IsZero() {
 			// calculate site power after a phase switch from activePhases phases -> 1 phase
@@ -1238,8 +1372,9 @@ func (lp *Loadpoint) pvMaxCurrent(mode api.ChargeMode, sitePower float64, batter
 
 	if mode == api.ModePV && !lp.enabled {
 		// kick off enable sequence
-		if (lp.Enable.Threshold == 0 && targetCurrent >= minCurrent) ||
-			(lp.Enable.Threshold != 0 && sitePower <= lp.Enable.Threshold) {
+		if ((lp.Enable.Threshold == 0 && targetCurrent >= minCurrent) ||
+			(lp.Enable.Threshold != 0 && sitePower <= lp.Enable.Threshold)) &&
+			(lp.SurplusHysteresis == 0 || targetCurrent >= minCurrent+hysteresisCurrent) {
 			lp.log.DEBUG.Printf("site power %.0fW <= %.0fW enable threshold", sitePower, lp.Enable.Threshold)
 
 			if lp.pvTimer.IsZero() {
@@ -1301,7 +1436,7 @@ func (lp *Loadpoint) UpdateChargePower() {
 	}
 }
 
-// updateChargeCurrents uses PhaseCurrents interface to count phases with current >=1A
+// updateChargeCurrents uses PhaseCurrents interface to count phases with current above minPhaseCurrent
 func (lp *Loadpoint) updateChargeCurrents() {
 	lp.chargeCurrents = nil
 
@@ -1316,6 +1451,9 @@ func (lp *Loadpoint) updateChargeCurrents() {
 		return
 	}
 
+	// currents are tracked in grid phase order
+	i1, i2, i3 = lp.remapPhases(i1, i2, i3)
+
 	lp.chargeCurrents = []float64{i1, i2, i3}
 	lp.log.DEBUG.Printf("charge currents: %.3gA", lp.chargeCurrents)
 	lp.publish(keys.ChargeCurrents, lp.chargeCurrents)
@@ -1323,7 +1461,7 @@ func (lp *Loadpoint) updateChargeCurrents() {
 	if lp.charging() && lp.phaseSwitchCompleted() {
 		var phases int
 		for _, i := range lp.chargeCurrents {
-			if i > minActiveCurrent {
+			if i > lp.minPhaseCurrent() {
 				phases++
 			}
 		}
@@ -1339,6 +1477,14 @@ func (lp *Loadpoint) updateChargeCurrents() {
 	}
 }
 
+// minPhaseCurrent returns the current threshold for detecting active phases
+func (lp *Loadpoint) minPhaseCurrent() float64 {
+	if lp.MinPhaseCurrent > 0 {
+		return lp.MinPhaseCurrent
+	}
+	return minActiveCurrent
+}
+
 // updateChargeVoltages uses PhaseVoltages interface to count phases with nominal grid voltage
 func (lp *Loadpoint) updateChargeVoltages() {
 	if lp.hasPhaseSwitching() {
@@ -1356,7 +1502,9 @@ func (lp *Loadpoint) updateChargeVoltages() {
 		return
 	}
 
-	chargeVoltages := []float64{u1, u2, u3}
+	// publish in grid phase order, wiring checks below use charger phase order
+	g1, g2, g3 := lp.remapPhases(u1, u2, u3)
+	chargeVoltages := []float64{g1, g2, g3}
 	lp.log.DEBUG.Printf("charge voltages: %.3gV", chargeVoltages)
 	lp.publish(keys.ChargeVoltages, chargeVoltages)
 
@@ -1406,6 +1554,12 @@ func (lp *Loadpoint) publishChargeProgress() {
 	// TODO deprecated: use sessionEnergy instead
 	lp.publish(keys.ChargedEnergy, lp.getChargedEnergy())
 	lp.publish(keys.ChargeDuration, lp.chargeDuration)
+	lp.publish(keys.MinChargeDurationRemaining, lp.minChargeDurationRemaining())
+	if f, ok := lp.remainingLimitEnergy(); ok {
+		lp.publish(keys.LimitEnergyRemaining, f)
+	} else {
+		lp.publish(keys.LimitEnergyRemaining, nil)
+	}
 	if _, ok := lp.chargeMeter.(api.MeterEnergy); ok {
 		lp.publish(keys.ChargeTotalImport, lp.chargeMeterTotal())
 	}
@@ -1431,6 +1585,8 @@ func (lp *Loadpoint) publishSocAndRange() {
 		lp.socUpdated = lp.clock.Now()
 
 		f, err := lp.socEstimator.Soc(lp.getChargedEnergy())
+		lp.updateVehicleOffline()
+
 		if err != nil {
 			if errors.Is(err, api.ErrMustRetry) {
 				lp.socUpdated = time.Time{}
@@ -1562,6 +1718,9 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 	lp.publish(keys.Connected, lp.connected())
 	lp.publish(keys.Charging, lp.charging())
 
+	// try to clear transient charger faults
+	lp.recoverFault()
+
 	// identify connected vehicle
 	if lp.connected() && !lp.chargerHasFeature(api.IntegratedDevice) {
 		// read identity and run associated action
@@ -1583,6 +1742,9 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 		return
 	}
 
+	// limit vehicle soc using the charger
+	lp.syncVehicleSocLimit()
+
 	// check if car connected and ready for charging
 	var err error
 
@@ -1592,11 +1754,21 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 	mode := lp.GetMode()
 	lp.publish(keys.Mode, mode)
 
+	// guest vehicles have unknown soc and capacity, charge without tariff-aware scheduling
+	guest := lp.guestActive()
+	if guest {
+		autoCharge = false
+	}
+
 	// update and publish plan without being short-circuited by modes etc.
-	plannerActive := lp.plannerActive()
+	plannerActive := !guest && lp.plannerActive()
 
 	// execute loading strategy
 	switch {
+	case lp.maintenanceActive(), lp.frequencyPaused(), lp.gridProtectionStopped(), lp.getTemperatureDerating() >= 1, lp.gridAllocationStop():
+		// charger unavailable during site maintenance, grid instability, ambient overtemperature or insufficient grid capacity
+		err = lp.setLimit(0)
+
 	case !lp.connected():
 		// always disable charger if not connected
 		// https://github.com/evcc-io/evcc/issues/105
@@ -1612,6 +1784,15 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 	case mode == api.ModeOff:
 		err = lp.setLimit(0)
 
+	// boost charging from grid ignoring pv, tariffs and charge limits until the vehicle is full
+	case mode == api.ModeBoost:
+		err = lp.fastCharging()
+		lp.resetPhaseTimer() // discard phase switch pending from pv mode, no-op if not running
+
+	// price spike unless vehicle is below min soc
+	case lp.tariffCeilingPause && !lp.minSocNotReached():
+		err = lp.setLimit(0)
+
 	// minimum or target charging
 	case lp.minSocNotReached() || plannerActive:
 		err = lp.fastCharging()
@@ -1630,6 +1811,13 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 	case mode == api.ModeNow:
 		err = lp.fastCharging()
 
+	// plan-only charging, the planner starts charging as late as possible for the plan time
+	case mode == api.ModeLazy:
+		if lp.EffectivePlanTime().IsZero() {
+			lp.log.DEBUG.Println("lazy: no plan")
+		}
+		err = lp.setLimit(0)
+
 	case mode == api.ModeMinPV || mode == api.ModePV:
 		// cheap tariff
 		if autoCharge && lp.EffectivePlanTime().IsZero() {
@@ -1641,7 +1829,7 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 
 		targetCurrent := lp.pvMaxCurrent(mode, sitePower, batteryBuffered, batteryStart)
 
-		if targetCurrent == 0 && lp.vehicleClimateActive() {
+		if targetCurrent == 0 && (lp.vehicleClimateActive() || lp.minChargeDurationActive()) {
 			targetCurrent = lp.effectiveMinCurrent()
 		}
 
@@ -144,6 +144,8 @@ type API interface {
 	GetVehicle() api.Vehicle
 	// SetVehicle sets the active vehicle
 	SetVehicle(vehicle api.Vehicle)
+	// GetVehicleSoc returns the vehicle soc, 0 if unknown
+	GetVehicleSoc() float64
 	// StartVehicleDetection allows triggering vehicle detection for debugging purposes
 	StartVehicleDetection()
 }
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/keys"
+)
+
+// getGridAllocation returns the charge power allocated by the site and if the allocation applies
+func (lp *Loadpoint) getGridAllocation() (float64, bool) {
+	lp.RLock()
+	defer lp.RUnlock()
+	return lp.gridAllocation, lp.gridAllocated
+}
+
+// setGridAllocation sets the charge power allocated by the site, allocated is false if grid capacity is unlimited
+func (lp *Loadpoint) setGridAllocation(power float64, allocated bool) {
+	lp.Lock()
+	changed := lp.gridAllocation != power || lp.gridAllocated != allocated
+	lp.gridAllocation = power
+	lp.gridAllocated = allocated
+	lp.Unlock()
+
+	if changed {
+		if allocated {
+			lp.publish(keys.GridAllocation, power)
+		} else {
+			lp.publish(keys.GridAllocation, nil)
+		}
+	}
+}
+
+// gridAllocationStop returns true if no grid capacity is allocated to the loadpoint
+func (lp *Loadpoint) gridAllocationStop() bool {
+	power, allocated := lp.getGridAllocation()
+	return allocated && power == 0
+}
+
+// gridDemandActive returns if the loadpoint is charging or about to be enabled.
+// Idle loadpoints waiting for pv surplus or a plan do not reserve grid capacity.
+func (lp *Loadpoint) gridDemandActive() bool {
+	if lp.charging() || lp.enabled {
+		return true
+	}
+
+	switch lp.GetMode() {
+	case api.ModePV:
+		// pv enable timer running
+		return !lp.pvTimer.IsZero()
+	case api.ModeLazy:
+		return false
+	default:
+		return true
+	}
+}
+
+// gridDemand returns the loadpoint's grid capacity demand or false if the loadpoint does not demand power
+func (lp *Loadpoint) gridDemand() (allocationDemand, bool) {
+	if lp.GetStatus() == api.StatusA || lp.GetMode() == api.ModeOff || !lp.gridDemandActive() {
+		return allocationDemand{}, false
+	}
+
+	phases := float64(lp.ActivePhases())
+
+	return allocationDemand{
+		priority: lp.EffectivePriority(),
+		minPower: Voltage * lp.effectiveMinCurrent() * phases,
+		maxPower: Voltage * lp.unallocatedMaxCurrent() * phases,
+	}, true
+}
@@ -55,11 +55,12 @@ func (lp *Loadpoint) SetMode(mode api.ChargeMode) {
 
 	// apply immediately
 	if lp.mode != mode {
+		prev := lp.mode
 		lp.setMode(mode)
 
 		// reset timers
 		switch mode {
-		case api.ModeNow, api.ModeOff:
+		case api.ModeNow, api.ModeOff, api.ModeLazy, api.ModeBoost:
 			lp.resetPhaseTimer()
 			lp.resetPVTimer()
 			lp.setPlanActive(false)
@@ -67,6 +68,11 @@ func (lp *Loadpoint) SetMode(mode api.ChargeMode) {
 			lp.resetPVTimer()
 		}
 
+		// let PV modes disable immediately after boosting
+		if prev == api.ModeBoost {
+			lp.elapsePVTimer()
+		}
+
 		lp.requestUpdate()
 	}
 }
@@ -311,7 +317,7 @@ func (lp *Loadpoint) GetChargePower() float64 {
 func (lp *Loadpoint) GetChargePowerFlexibility() float64 {
 	// no locking
 	mode := lp.GetMode()
-	if mode == api.ModeNow || !lp.charging() || lp.minSocNotReached() {
+	if mode == api.ModeNow || mode == api.ModeBoost || !lp.charging() || lp.minSocNotReached() {
 		return 0
 	}
 
@@ -400,7 +406,7 @@ func (lp *Loadpoint) IsFastChargingActive() bool {
 	lp.RLock()
 	defer lp.RUnlock()
 
-	return lp.mode == api.ModeNow || lp.planActive || lp.minSocNotReached()
+	return lp.mode == api.ModeNow || lp.mode == api.ModeBoost || lp.planActive || lp.minSocNotReached()
 }
 
 // GetRemainingDuration is the estimated remaining charging duration
@@ -454,6 +460,13 @@ func (lp *Loadpoint) GetVehicle() api.Vehicle {
 	return lp.vehicle
 }
 
+// GetVehicleSoc returns the vehicle soc, 0 if unknown
+func (lp *Loadpoint) GetVehicleSoc() float64 {
+	lp.RLock()
+	defer lp.RUnlock()
+	return lp.vehicleSoc
+}
+
 // SetVehicle sets the active vehicle
 func (lp *Loadpoint) SetVehicle(vehicle api.Vehicle) {
 	// set desired vehicle (protected by lock, no locking here)
@@ -1,11 +1,16 @@
 package core
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"slices"
+	"time"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/keys"
 	"github.com/evcc-io/evcc/core/soc"
+	"github.com/evcc-io/evcc/util/modbus"
 )
 
 // chargerHasFeature checks availability of charger feature
@@ -33,3 +38,125 @@ func (lp *Loadpoint) chargerSoc() (float64, error) {
 	}
 	return 0, api.ErrNotAvailable
 }
+
+// maintenanceActive returns if the site maintenance window is active
+func (lp *Loadpoint) maintenanceActive() bool {
+	lp.RLock()
+	defer lp.RUnlock()
+	return lp.maintenance
+}
+
+// setMaintenance sets the site maintenance status
+func (lp *Loadpoint) setMaintenance(active bool) {
+	lp.Lock()
+	changed := lp.maintenance != active
+	lp.maintenance = active
+	lp.Unlock()
+
+	if changed {
+		if active {
+			lp.log.INFO.Println("maintenance started: charger unavailable")
+		} else {
+			lp.log.INFO.Println("maintenance finished")
+		}
+	}
+
+	lp.publish(keys.Maintenance, active)
+}
+
+// frequencyPaused returns if charging is paused due to grid frequency deviation
+func (lp *Loadpoint) frequencyPaused() bool {
+	lp.RLock()
+	defer lp.RUnlock()
+	return lp.frequencyPause
+}
+
+// setFrequencyPause pauses charging due to grid frequency deviation
+func (lp *Loadpoint) setFrequencyPause(active bool) {
+	lp.Lock()
+	changed := lp.frequencyPause != active
+	lp.frequencyPause = active
+	lp.Unlock()
+
+	if changed {
+		if active {
+			lp.log.WARN.Println("grid frequency deviation: charging paused")
+		} else {
+			lp.log.INFO.Println("grid frequency normal: charging resumed")
+		}
+	}
+}
+
+// gridProtectionStopped returns if charging is stopped due to a grid protection relay fault
+func (lp *Loadpoint) gridProtectionStopped() bool {
+	lp.RLock()
+	defer lp.RUnlock()
+	return lp.gridProtection
+}
+
+// setGridProtection stops charging due to a grid protection relay fault and returns if the state changed
+func (lp *Loadpoint) setGridProtection(active bool) bool {
+	lp.Lock()
+	changed := lp.gridProtection != active
+	lp.gridProtection = active
+	lp.Unlock()
+
+	if changed {
+		if active {
+			lp.log.WARN.Println("grid protection fault: charging stopped")
+		} else {
+			lp.log.INFO.Println("grid protection confirmed: charging resumed")
+		}
+	}
+
+	return changed
+}
+
+// getTemperatureDerating returns the max current reduction due to ambient temperature
+func (lp *Loadpoint) getTemperatureDerating() float64 {
+	lp.RLock()
+	defer lp.RUnlock()
+	return lp.temperatureDerating
+}
+
+// setTemperatureDerating sets the max current reduction due to ambient temperature
+func (lp *Loadpoint) setTemperatureDerating(derating float64) {
+	lp.Lock()
+	changed := lp.temperatureDerating != derating
+	lp.temperatureDerating = derating
+	lp.Unlock()
+
+	if changed {
+		lp.publish(keys.TemperatureDerating, math.Round(100*derating))
+	}
+}
+
+// handleChargerEvents requests a loadpoint update for each charger event
+func (lp *Loadpoint) handleChargerEvents(events <-chan api.ChargerEvent) {
+	for ev := range events {
+		lp.log.DEBUG.Printf("charger event: %s", ev.Type)
+		lp.requestUpdate()
+	}
+}
+
+// chargerError adds the charger name to modbus exception responses for easier diagnosis
+func (lp *Loadpoint) chargerError(err error) error {
+	var ee *modbus.ExceptionError
+	if lp.ChargerRef != "" && errors.As(err, &ee) {
+		return fmt.Errorf("%s: %w", lp.ChargerRef, err)
+	}
+	return err
+}
+
+// minChargeDurationRemaining returns the time until the minimum charge duration has elapsed
+func (lp *Loadpoint) minChargeDurationRemaining() time.Duration {
+	if lp.MinChargeDuration <= 0 || lp.chargeStarted.IsZero() || lp.GetStatus() != api.StatusC {
+		return 0
+	}
+	return max(0, lp.MinChargeDuration-lp.clock.Since(lp.chargeStarted))
+}
+
+// minChargeDurationActive checks if a started charging session must not yet be disabled
+func (lp *Loadpoint) minChargeDurationActive() bool {
+	return lp.minChargeDurationRemaining() > 0
+}
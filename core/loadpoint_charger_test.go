@@ -0,0 +1,36 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinChargeDuration(t *testing.T) {
+	clck := clock.NewMock()
+
+	lp := &Loadpoint{
+		clock:             clck,
+		status:            api.StatusC,
+		MinChargeDuration: 5 * time.Minute,
+	}
+
+	// not charging
+	assert.False(t, lp.minChargeDurationActive())
+
+	lp.chargeStarted = clck.Now()
+	clck.Add(time.Minute)
+	assert.True(t, lp.minChargeDurationActive())
+	assert.Equal(t, 4*time.Minute, lp.minChargeDurationRemaining())
+
+	// vehicle stopped charging
+	lp.status = api.StatusB
+	assert.False(t, lp.minChargeDurationActive())
+
+	lp.status = api.StatusC
+	clck.Add(4 * time.Minute)
+	assert.False(t, lp.minChargeDurationActive())
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"math"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// Current rounding modes
+const (
+	roundingFloor       = "floor"
+	roundingCeil        = "ceil"
+	roundingRound       = "round"
+	roundingNearestEven = "nearest-even"
+)
+
+// roundCurrent applies the configured rounding to the charge current.
+// Chargers without fractional current support always use full amps, rounding down by default.
+func (lp *Loadpoint) roundCurrent(current float64) float64 {
+	rounding := lp.CurrentRounding
+	if rounding == "" {
+		// full amps only?
+		if _, ok := lp.charger.(api.ChargerEx); ok && !lp.vehicleHasFeature(api.CoarseCurrent) {
+			return lp.stepCurrent(current)
+		}
+		rounding = roundingFloor
+	}
+
+	var res float64
+	switch rounding {
+	case roundingCeil:
+		res = math.Ceil(current)
+	case roundingRound:
+		res = math.Round(current)
+	case roundingNearestEven:
+		res = math.RoundToEven(current)
+	default:
+		res = math.Floor(current)
+	}
+
+	// never exceed the maximum current when rounding up
+	if res > current && res > lp.effectiveMaxCurrent() {
+		res = math.Floor(current)
+	}
+
+	return res
+}
+
+// stepCurrent rounds the charge current down to the step size of chargers with fractional current support.
+// Currents crossing a step boundary are applied immediately, smaller changes do not cause charger writes.
+func (lp *Loadpoint) stepCurrent(current float64) float64 {
+	step := lp.CurrentStepSize
+	if step <= 0 {
+		return current
+	}
+
+	// tolerate floating point representation of step multiples
+	res := math.Round(math.Floor(current/step+1e-9)*step*1e3) / 1e3
+
+	// never round below min current
+	if minCurrent := lp.effectiveMinCurrent(); res < minCurrent && current >= minCurrent {
+		return minCurrent
+	}
+
+	return res
+}
+
+// withinDeadband checks if the charge current change is too small to update the charger
+func (lp *Loadpoint) withinDeadband(current float64) bool {
+	return lp.CurrentDeadband > 0 && lp.enabled && lp.chargeCurrent >= lp.effectiveMinCurrent() &&
+		math.Abs(current-lp.chargeCurrent) < lp.CurrentDeadband
+}
+
+// startupRampCurrent limits the charge current to the initial current after the charger is first enabled within a session.
+// Since the current is set before enabling the charger, the charger never sees a higher current at session start.
+func (lp *Loadpoint) startupRampCurrent(current float64) float64 {
+	if lp.StartupRamp.RampTime <= 0 || current < lp.effectiveMinCurrent() {
+		return current
+	}
+
+	if lp.startupRampPending && !lp.enabled {
+		lp.startupRampPending = false
+		lp.startupRampStart = lp.clock.Now()
+		lp.log.DEBUG.Printf("startup ramp: %v", lp.StartupRamp.RampTime)
+	}
+
+	if lp.startupRampStart.IsZero() || lp.clock.Since(lp.startupRampStart) >= lp.StartupRamp.RampTime {
+		return current
+	}
+
+	return min(current, max(lp.StartupRamp.InitialCurrent, lp.effectiveMinCurrent()))
+}
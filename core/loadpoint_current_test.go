@@ -0,0 +1,125 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCurrentRounding(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp := &Loadpoint{
+		charger:    api.NewMockCharger(ctrl),
+		minCurrent: minA,
+		maxCurrent: maxA,
+	}
+
+	for _, tc := range []struct {
+		rounding        string
+		current, expect float64
+	}{
+		{"", 10.7, 10},
+		{roundingFloor, 10.7, 10},
+		{roundingCeil, 10.3, 11},
+		{roundingCeil, 16.5, 16}, // never exceed max current
+		{roundingRound, 10.5, 11},
+		{roundingNearestEven, 10.5, 10},
+		{roundingNearestEven, 11.5, 12},
+	} {
+		lp.CurrentRounding = tc.rounding
+		assert.Equal(t, tc.expect, lp.roundCurrent(tc.current), tc)
+	}
+}
+
+type fractionalCharger struct {
+	*api.MockCharger
+}
+
+func (c fractionalCharger) MaxCurrentMillis(float64) error {
+	return nil
+}
+
+func TestCurrentStepSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp := &Loadpoint{
+		charger:    fractionalCharger{api.NewMockCharger(ctrl)},
+		minCurrent: minA,
+		maxCurrent: maxA,
+	}
+
+	for _, tc := range []struct {
+		step, current, expect float64
+	}{
+		{0, 10.37, 10.37},
+		{0.1, 10.37, 10.3},
+		{0.1, 10.3, 10.3},
+		{0.1, 10.39, 10.3},
+		{0.1, 10.4, 10.4}, // step boundary crossed
+		{0.5, 10.37, 10},
+		{0.5, 6.2, 6},
+		{4, 7, 6}, // never below min current
+	} {
+		lp.CurrentStepSize = tc.step
+		assert.Equal(t, tc.expect, lp.roundCurrent(tc.current), tc)
+	}
+}
+
+func TestCurrentDeadband(t *testing.T) {
+	lp := &Loadpoint{
+		minCurrent:      minA,
+		maxCurrent:      maxA,
+		chargeCurrent:   10,
+		enabled:         true,
+		CurrentDeadband: 0.5,
+	}
+
+	assert.True(t, lp.withinDeadband(10.3))
+	assert.False(t, lp.withinDeadband(10.5))
+	assert.False(t, lp.withinDeadband(9))
+
+	lp.enabled = false
+	assert.False(t, lp.withinDeadband(10.3))
+}
+
+func TestStartupRamp(t *testing.T) {
+	clck := clock.NewMock()
+
+	lp := &Loadpoint{
+		log:                util.NewLogger("foo"),
+		clock:              clck,
+		minCurrent:         minA,
+		maxCurrent:         maxA,
+		startupRampPending: true,
+		StartupRamp: StartupRampConfig{
+			InitialCurrent: 8,
+			RampTime:       30 * time.Second,
+		},
+	}
+
+	// disabled charger is not affected
+	assert.Equal(t, 0.0, lp.startupRampCurrent(0))
+	assert.True(t, lp.startupRampPending)
+
+	// ramp starts on enable
+	assert.Equal(t, 8.0, lp.startupRampCurrent(maxA))
+	assert.False(t, lp.startupRampPending)
+	lp.enabled = true
+
+	clck.Add(29 * time.Second)
+	assert.Equal(t, 8.0, lp.startupRampCurrent(maxA))
+	assert.Equal(t, 7.0, lp.startupRampCurrent(7))
+
+	clck.Add(time.Second)
+	assert.Equal(t, maxA, lp.startupRampCurrent(maxA))
+
+	// only applies once per session
+	lp.enabled = false
+	assert.Equal(t, maxA, lp.startupRampCurrent(maxA))
+}
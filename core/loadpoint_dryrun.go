@@ -0,0 +1,47 @@
+package core
+
+import "github.com/evcc-io/evcc/api"
+
+// chargerEnable enables or disables the charger, only logging the write in dry-run mode
+func (lp *Loadpoint) chargerEnable(enable bool) error {
+	if lp.DryRun {
+		lp.log.INFO.Printf("DRY-RUN: would write enable value %t", enable)
+		return nil
+	}
+
+	return lp.charger.Enable(enable)
+}
+
+// chargerMaxCurrent sets the charger current limit, only logging the write in dry-run mode
+func (lp *Loadpoint) chargerMaxCurrent(current float64) error {
+	if lp.DryRun {
+		lp.log.INFO.Printf("DRY-RUN: would write max current value %.3gA", current)
+		return nil
+	}
+
+	if charger, ok := lp.charger.(api.ChargerEx); ok {
+		return charger.MaxCurrentMillis(current)
+	}
+
+	return lp.charger.MaxCurrent(int64(current))
+}
+
+// chargerPhases1p3p switches the charger phases, only logging the write in dry-run mode
+func (lp *Loadpoint) chargerPhases1p3p(phases int) error {
+	if lp.DryRun {
+		lp.log.INFO.Printf("DRY-RUN: would write phases value %dp", phases)
+		return nil
+	}
+
+	return lp.charger.(api.PhaseSwitcher).Phases1p3p(phases)
+}
+
+// chargerSetVehicleSocLimit sets the charger's vehicle soc limit, only logging the write in dry-run mode
+func (lp *Loadpoint) chargerSetVehicleSocLimit(limit int64) error {
+	if lp.DryRun {
+		lp.log.INFO.Printf("DRY-RUN: would write vehicle soc limit value %d%%", limit)
+		return nil
+	}
+
+	return lp.charger.(api.VehicleSocLimiter).SetVehicleSocLimit(limit)
+}
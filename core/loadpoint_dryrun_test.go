@@ -0,0 +1,39 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// no writes expected
+	charger := &struct {
+		*api.MockCharger
+		*api.MockPhaseSwitcher
+	}{
+		api.NewMockCharger(ctrl),
+		api.NewMockPhaseSwitcher(ctrl),
+	}
+
+	lp := &Loadpoint{
+		log:     util.NewLogger("foo"),
+		charger: charger,
+		DryRun:  true,
+	}
+
+	require.NoError(t, lp.chargerEnable(true))
+	require.NoError(t, lp.chargerMaxCurrent(16))
+	require.NoError(t, lp.chargerPhases1p3p(1))
+
+	// state is read but not synchronized
+	charger.MockCharger.EXPECT().Enabled().Return(false, nil)
+	lp.enabled = true
+	require.NoError(t, lp.syncCharger())
+	require.True(t, lp.enabled)
+}
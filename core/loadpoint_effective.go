@@ -87,12 +87,39 @@ func (lp *Loadpoint) effectiveMinCurrent() float64 {
 
 // effectiveMaxCurrent returns the effective max current
 func (lp *Loadpoint) effectiveMaxCurrent() float64 {
+	maxCurrent := lp.unallocatedMaxCurrent()
+
+	// site grid capacity allocation
+	if power, ok := lp.getGridAllocation(); ok && power > 0 {
+		maxCurrent = min(maxCurrent, power/Voltage/float64(lp.ActivePhases()))
+	}
+
+	return maxCurrent
+}
+
+// unallocatedMaxCurrent returns the effective max current before applying the site's grid capacity allocation
+func (lp *Loadpoint) unallocatedMaxCurrent() float64 {
 	maxCurrent := lp.GetMaxCurrent()
 
+	// tariff zone limit replaces the loadpoint's max current
+	if res, ok := lp.tariffMaxCurrent(); ok {
+		maxCurrent = res
+	}
+
 	if v := lp.GetVehicle(); v != nil {
 		if res, ok := v.OnIdentified().GetMaxCurrent(); ok && res > 0 {
 			maxCurrent = min(maxCurrent, res)
 		}
+
+		if lp.vehicleOBCLimit > 0 {
+			maxCurrent = min(maxCurrent, lp.vehicleOBCLimit)
+		}
+
+		if lp.vehicleOffline {
+			maxCurrent = min(maxCurrent, lp.VehicleOfflineCurrent)
+		}
+	} else if lp.guest {
+		maxCurrent = min(maxCurrent, lp.GuestMode.MaxCurrent)
 	}
 
 	if c, ok := lp.charger.(api.CurrentLimiter); ok {
@@ -101,6 +128,11 @@ func (lp *Loadpoint) effectiveMaxCurrent() float64 {
 		}
 	}
 
+	// ambient temperature derating, not below min current since only stopping reduces current further
+	if derating := lp.getTemperatureDerating(); derating > 0 && derating < 1 {
+		maxCurrent = max(maxCurrent*(1-derating), min(maxCurrent, lp.effectiveMinCurrent()))
+	}
+
 	return maxCurrent
 }
 
@@ -68,3 +68,21 @@ func TestEffectiveMinMaxCurrent(t *testing.T) {
 		assert.Equal(t, tc.effectiveMax, lp.effectiveMaxCurrent(), "max")
 	}
 }
+
+func TestEffectiveMaxCurrentOBCLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	vehicle := api.NewMockVehicle(ctrl)
+	vehicle.EXPECT().OnIdentified().Return(api.ActionConfig{}).AnyTimes()
+
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.charger = api.NewMockCharger(ctrl)
+	lp.vehicle = vehicle
+
+	// on-board charger limit below loadpoint max current
+	lp.vehicleOBCLimit = 10
+	assert.Equal(t, 10.0, lp.effectiveMaxCurrent())
+
+	lp.vehicleOBCLimit = 20
+	assert.Equal(t, 16.0, lp.effectiveMaxCurrent())
+}
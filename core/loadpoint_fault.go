@@ -0,0 +1,94 @@
+package core
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+const (
+	faultRecoveryAuto = "auto"
+
+	faultRecoveryDelay    = 30 * time.Second
+	faultRecoveryAttempts = 3
+)
+
+// faultRecoveryDelay returns the delay before attempting to recover from a charger fault
+func (lp *Loadpoint) faultRecoveryDelay() time.Duration {
+	if lp.FaultRecoveryDelay > 0 {
+		return lp.FaultRecoveryDelay
+	}
+	return faultRecoveryDelay
+}
+
+// faultRecoveryAttempts returns the maximum number of charger fault recovery attempts
+func (lp *Loadpoint) faultRecoveryAttempts() int {
+	if lp.FaultRecoveryAttempts > 0 {
+		return lp.FaultRecoveryAttempts
+	}
+	return faultRecoveryAttempts
+}
+
+// faultCode returns the charger's fault code, falling back to the charger status
+func (lp *Loadpoint) faultCode() string {
+	if fr, ok := lp.charger.(api.FaultReporter); ok {
+		if code, err := fr.FaultCode(); err == nil {
+			return code
+		}
+	}
+	return lp.GetStatus().String()
+}
+
+// recoverFault tries to clear a transient charger fault by toggling the charger's enabled state.
+// The enabled state is restored afterwards, a disabled charger is not re-enabled.
+func (lp *Loadpoint) recoverFault() {
+	if lp.FaultRecovery != faultRecoveryAuto || lp.maintenanceActive() {
+		return
+	}
+
+	if status := lp.GetStatus(); status != api.StatusF {
+		if !lp.faultTimer.IsZero() {
+			if lp.faultAttempts > 0 {
+				lp.log.INFO.Printf("charger fault recovered: status %s", status)
+			}
+			lp.faultTimer = time.Time{}
+			lp.faultAttempts = 0
+		}
+		return
+	}
+
+	if lp.faultTimer.IsZero() {
+		lp.faultTimer = lp.clock.Now()
+		return
+	}
+
+	attempts := lp.faultRecoveryAttempts()
+	if lp.faultAttempts >= attempts || lp.clock.Since(lp.faultTimer) < lp.faultRecoveryDelay() {
+		return
+	}
+
+	lp.faultAttempts++
+	lp.faultTimer = lp.clock.Now()
+
+	code := lp.faultCode()
+	lp.log.WARN.Printf("charger fault: %s, recovery attempt %d/%d", code, lp.faultAttempts, attempts)
+
+	if err := lp.chargerEnable(false); err != nil {
+		lp.log.ERROR.Printf("charger fault recovery: %v", err)
+	} else if lp.enabled {
+		if err := lp.chargerEnable(true); err != nil {
+			lp.log.ERROR.Printf("charger fault recovery: %v", err)
+		}
+	}
+
+	status, err := lp.charger.Status()
+	if err == nil && status != api.StatusF {
+		// recovery is confirmed by the next status update
+		return
+	}
+
+	if lp.faultAttempts >= attempts {
+		lp.log.ERROR.Printf("charger fault: %s, giving up after %d recovery attempts", code, lp.faultAttempts)
+		lp.pushEvent(evChargerFault)
+	}
+}
@@ -0,0 +1,96 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFaultRecovery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := api.NewMockCharger(ctrl)
+	clck := clock.NewMock()
+	pushChan := make(chan push.Event, 1)
+
+	lp := &Loadpoint{
+		log:                   util.NewLogger("foo"),
+		clock:                 clck,
+		charger:               charger,
+		pushChan:              pushChan,
+		status:                api.StatusF,
+		enabled:               true,
+		FaultRecovery:         faultRecoveryAuto,
+		FaultRecoveryAttempts: 2,
+	}
+
+	// fault detected
+	lp.recoverFault()
+	assert.Equal(t, 0, lp.faultAttempts)
+
+	// delay not elapsed
+	clck.Add(10 * time.Second)
+	lp.recoverFault()
+	assert.Equal(t, 0, lp.faultAttempts)
+
+	for i := 1; i <= 2; i++ {
+		clck.Add(faultRecoveryDelay)
+		charger.EXPECT().Enable(false).Return(nil)
+		charger.EXPECT().Enable(true).Return(nil)
+		charger.EXPECT().Status().Return(api.StatusF, nil)
+		lp.recoverFault()
+		assert.Equal(t, i, lp.faultAttempts)
+	}
+
+	// alert raised after last attempt
+	assert.Equal(t, push.Event{Event: evChargerFault}, <-pushChan)
+
+	// no further attempts
+	clck.Add(faultRecoveryDelay)
+	lp.recoverFault()
+	assert.Equal(t, 2, lp.faultAttempts)
+
+	// fault cleared
+	lp.status = api.StatusB
+	lp.recoverFault()
+	assert.Equal(t, 0, lp.faultAttempts)
+	assert.True(t, lp.faultTimer.IsZero())
+}
+
+type faultCharger struct {
+	*api.MockCharger
+}
+
+func (c faultCharger) FaultCode() (string, error) {
+	return "E42", nil
+}
+
+func TestFaultRecoveryDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := faultCharger{api.NewMockCharger(ctrl)}
+	clck := clock.NewMock()
+
+	lp := &Loadpoint{
+		log:           util.NewLogger("foo"),
+		clock:         clck,
+		charger:       charger,
+		status:        api.StatusF,
+		FaultRecovery: faultRecoveryAuto,
+	}
+
+	assert.Equal(t, "E42", lp.faultCode())
+
+	lp.recoverFault()
+	clck.Add(faultRecoveryDelay)
+
+	// disabled charger is not re-enabled
+	charger.MockCharger.EXPECT().Enable(false).Return(nil)
+	charger.MockCharger.EXPECT().Status().Return(api.StatusB, nil)
+	lp.recoverFault()
+	assert.Equal(t, 1, lp.faultAttempts)
+}
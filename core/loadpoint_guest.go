@@ -0,0 +1,59 @@
+package core
+
+import (
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/core/session"
+)
+
+const (
+	guestVehicleTitle = "Guest" // session vehicle of guest sessions
+	guestVehicleID    = "guest" // session identifier of guest sessions without charger identification
+)
+
+// GuestModeConfig allows unidentified vehicles to charge at reduced current
+type GuestModeConfig struct {
+	Enabled    bool    `mapstructure:"enabled"`
+	MaxCurrent float64 `mapstructure:"maxCurrent"` // Max charge current of unidentified vehicles
+}
+
+// validateGuestMode disables guest mode without valid max current
+func (lp *Loadpoint) validateGuestMode() {
+	if lp.GuestMode.Enabled && lp.GuestMode.MaxCurrent <= 0 {
+		lp.log.WARN.Printf("invalid guest mode max current: %.3gA", lp.GuestMode.MaxCurrent)
+		lp.GuestMode.Enabled = false
+	}
+}
+
+// guestActive updates and returns if an unidentified vehicle is charging as guest
+func (lp *Loadpoint) guestActive() bool {
+	active := lp.GuestMode.Enabled && lp.connected() && lp.GetVehicle() == nil && !lp.chargerHasFeature(api.IntegratedDevice)
+	if active == lp.guest {
+		return active
+	}
+
+	lp.guest = active
+	lp.publish(keys.VehicleGuest, active)
+
+	if !active {
+		// vehicle identified or disconnected
+		lp.updateSession(func(session *session.Session) {
+			if session.Identifier == guestVehicleID {
+				session.Identifier = ""
+			}
+		})
+
+		return active
+	}
+
+	lp.log.INFO.Printf("guest vehicle: max current %.3gA", lp.GuestMode.MaxCurrent)
+
+	lp.updateSession(func(session *session.Session) {
+		session.Vehicle = guestVehicleTitle
+		if session.Identifier == "" {
+			session.Identifier = guestVehicleID
+		}
+	})
+
+	return active
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGuestMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.charger = api.NewMockCharger(ctrl)
+	lp.GuestMode = GuestModeConfig{Enabled: true, MaxCurrent: 10}
+
+	// not connected
+	lp.status = api.StatusA
+	assert.False(t, lp.guestActive())
+	assert.Equal(t, 16.0, lp.effectiveMaxCurrent())
+
+	// unidentified vehicle
+	lp.status = api.StatusB
+	assert.True(t, lp.guestActive())
+	assert.Equal(t, 10.0, lp.effectiveMaxCurrent())
+
+	// vehicle identified
+	lp.vehicle = api.NewMockVehicle(ctrl)
+	lp.vehicle.(*api.MockVehicle).EXPECT().OnIdentified().Return(api.ActionConfig{}).AnyTimes()
+	assert.False(t, lp.guestActive())
+	assert.Equal(t, 16.0, lp.effectiveMaxCurrent())
+}
+
+func TestGuestModeValidation(t *testing.T) {
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.GuestMode = GuestModeConfig{Enabled: true}
+
+	lp.validateGuestMode()
+	assert.False(t, lp.GuestMode.Enabled)
+}
@@ -1,6 +1,9 @@
 package core
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/keys"
 )
@@ -114,3 +117,48 @@ func (lp *Loadpoint) hasPhaseSwitching() bool {
 	_, ok := lp.charger.(api.PhaseSwitcher)
 	return ok
 }
+
+// validatePhaseMapping checks that the phase mapping is a permutation of the three grid phases.
+// Phase switching chargers always charge 1p on their L1, which must therefore be connected to grid L1.
+func validatePhaseMapping(mapping []int, phaseSwitching bool) error {
+	if mapping == nil {
+		return nil
+	}
+
+	if len(mapping) != 3 {
+		return fmt.Errorf("invalid phase mapping: %v, need 3 phases", mapping)
+	}
+
+	var seen [3]bool
+	for _, p := range mapping {
+		if p < 1 || p > 3 || seen[p-1] {
+			return fmt.Errorf("invalid phase mapping: %v", mapping)
+		}
+		seen[p-1] = true
+	}
+
+	if phaseSwitching && mapping[0] != 1 {
+		return fmt.Errorf("invalid phase mapping: %v, phase switching requires charger L1 connected to grid L1", mapping)
+	}
+
+	return nil
+}
+
+// isStandardPhaseMapping returns true if charger phases are connected to the same grid phases
+func isStandardPhaseMapping(mapping []int) bool {
+	return mapping == nil || slices.Equal(mapping, []int{1, 2, 3})
+}
+
+// remapPhases converts per-phase values from charger phase order into grid phase order
+func (lp *Loadpoint) remapPhases(l1, l2, l3 float64) (float64, float64, float64) {
+	if isStandardPhaseMapping(lp.PhaseMapping) {
+		return l1, l2, l3
+	}
+
+	var res [3]float64
+	for i, v := range []float64{l1, l2, l3} {
+		res[lp.PhaseMapping[i]-1] = v
+	}
+
+	return res[0], res[1], res[2]
+}
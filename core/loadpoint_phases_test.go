@@ -435,3 +435,38 @@ func TestScalePhasesIfAvailable(t *testing.T) {
 		ctrl.Finish()
 	}
 }
+
+func TestPhaseMapping(t *testing.T) {
+	for _, tc := range []struct {
+		mapping        []int
+		phaseSwitching bool
+		valid          bool
+	}{
+		{nil, false, true},
+		{[]int{1, 2, 3}, false, true},
+		{[]int{3, 2, 1}, false, true},
+		{[]int{2, 3, 1}, false, true},
+		{[]int{1, 2}, false, false},
+		{[]int{1, 1, 3}, false, false},
+		{[]int{0, 2, 3}, false, false},
+		{[]int{1, 2, 4}, false, false},
+		// 1p charging uses charger L1
+		{nil, true, true},
+		{[]int{1, 3, 2}, true, true},
+		{[]int{3, 2, 1}, true, false},
+		{[]int{2, 3, 1}, true, false},
+	} {
+		t.Log(tc)
+
+		err := validatePhaseMapping(tc.mapping, tc.phaseSwitching)
+		require.Equal(t, tc.valid, err == nil)
+	}
+
+	lp := &Loadpoint{PhaseMapping: []int{3, 2, 1}}
+	l1, l2, l3 := lp.remapPhases(1, 2, 3)
+	require.Equal(t, []float64{3, 2, 1}, []float64{l1, l2, l3})
+
+	lp.PhaseMapping = []int{2, 3, 1}
+	l1, l2, l3 = lp.remapPhases(1, 2, 3)
+	require.Equal(t, []float64{3, 1, 2}, []float64{l1, l2, l3})
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	adaptivePollMin = 2 * time.Second  // poll interval after charger status change
+	adaptivePollMax = 30 * time.Second // default poll interval once stable
+)
+
+// validateAdaptivePoll disables invalid adaptive polling and defaults the stable poll interval
+func (lp *Loadpoint) validateAdaptivePoll() {
+	if lp.StableAfter < 0 {
+		lp.log.WARN.Printf("invalid stable after: %v", lp.StableAfter)
+		lp.StableAfter = 0
+	}
+
+	if lp.StableAfter > 0 && lp.PollInterval <= 0 {
+		lp.PollInterval = adaptivePollMax
+	}
+}
+
+// statusChanged restarts adaptive polling at the min poll interval
+func (lp *Loadpoint) statusChanged() {
+	if lp.StableAfter <= 0 {
+		return
+	}
+
+	lp.Lock()
+	lp.statusUpdated = lp.clock.Now()
+	lp.Unlock()
+
+	select {
+	case lp.pollResetC <- struct{}{}:
+	default:
+	}
+}
+
+// nextPollInterval returns the poll interval. With adaptive polling, the interval backs off
+// exponentially from the min poll interval after a status change to the poll interval once stable.
+func (lp *Loadpoint) nextPollInterval() time.Duration {
+	if lp.StableAfter <= 0 || lp.PollInterval <= adaptivePollMin {
+		return lp.PollInterval
+	}
+
+	lp.RLock()
+	elapsed := lp.clock.Since(lp.statusUpdated)
+	lp.RUnlock()
+
+	if elapsed >= lp.StableAfter {
+		return lp.PollInterval
+	}
+
+	ratio := float64(lp.PollInterval) / float64(adaptivePollMin)
+	d := time.Duration(float64(adaptivePollMin) * math.Pow(ratio, float64(elapsed)/float64(lp.StableAfter)))
+
+	return min(d, lp.PollInterval)
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptivePoll(t *testing.T) {
+	clock := clock.NewMock()
+
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.clock = clock
+	lp.StableAfter = 5 * time.Minute
+
+	lp.validateAdaptivePoll()
+	assert.Equal(t, adaptivePollMax, lp.PollInterval)
+
+	// stable
+	assert.Equal(t, 30*time.Second, lp.nextPollInterval())
+
+	lp.statusChanged()
+	assert.Len(t, lp.pollResetC, 1)
+	assert.Equal(t, 2*time.Second, lp.nextPollInterval())
+
+	// exponential back off
+	clock.Add(150 * time.Second)
+	assert.InDelta(t, 7.746, lp.nextPollInterval().Seconds(), 0.001)
+
+	clock.Add(150 * time.Second)
+	assert.Equal(t, 30*time.Second, lp.nextPollInterval())
+
+	// fixed interval
+	lp.StableAfter = 0
+	lp.statusChanged()
+	assert.Equal(t, 30*time.Second, lp.nextPollInterval())
+}
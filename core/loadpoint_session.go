@@ -34,6 +34,8 @@ func (lp *Loadpoint) createSession() {
 
 	if vehicle := lp.GetVehicle(); vehicle != nil {
 		lp.session.Vehicle = vehicle.Title()
+	} else if lp.guest {
+		lp.session.Vehicle = guestVehicleTitle
 	}
 
 	if c, ok := lp.charger.(api.Identifier); ok {
@@ -71,9 +73,20 @@ func (lp *Loadpoint) stopSession() {
 	s.Price = lp.sessionEnergy.Price()
 	s.PricePerKWh = lp.sessionEnergy.PricePerKWh()
 	s.Co2PerKWh = lp.sessionEnergy.Co2PerKWh()
+	s.Co2 = lp.sessionEnergy.Co2()
 	s.ChargedEnergy = lp.sessionEnergy.TotalWh() / 1e3
 	s.ChargeDuration = &lp.chargeDuration
 
+	// distance driven since the vehicle's previous session
+	if s.Odometer != nil && s.Vehicle != "" {
+		if odo, ok := lp.db.PreviousOdometer(s.Vehicle, s.Created); ok && *s.Odometer > odo {
+			distance := *s.Odometer - odo
+			whPerKm := s.ChargedEnergy * 1e3 / distance
+			s.Distance = &distance
+			s.WhPerKm = &whPerKm
+		}
+	}
+
 	lp.db.Persist(s)
 }
 
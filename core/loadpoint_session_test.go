@@ -182,3 +182,48 @@ func createMockSessions(db *session.DB, clock *clock.Mock) []*session.Session {
 	}
 	return sessions
 }
+
+func TestSessionDistance(t *testing.T) {
+	var err error
+	serverdb.Instance, err = serverdb.New("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	db, err := session.NewStore("foo", serverdb.Instance)
+	require.NoError(t, err)
+
+	clock := clock.NewMock()
+
+	// previous session of same vehicle
+	odo := 1000.0
+	prev := db.New(0)
+	prev.Vehicle = "car"
+	prev.Created = clock.Now()
+	prev.Odometer = &odo
+	db.Persist(prev)
+
+	clock.Add(24 * time.Hour)
+
+	lp := &Loadpoint{
+		log:           util.NewLogger("foo"),
+		clock:         clock,
+		db:            db,
+		sessionEnergy: NewEnergyMetrics(),
+	}
+
+	lp.createSession()
+	lp.session.Vehicle = "car"
+	lp.updateSession(func(session *session.Session) {
+		odo := 1100.0
+		session.Created = lp.clock.Now()
+		session.Odometer = &odo
+	})
+
+	clock.Add(time.Hour)
+	lp.sessionEnergy.Update(15)
+	lp.stopSession()
+
+	require.NotNil(t, lp.session.Distance)
+	assert.Equal(t, 100.0, *lp.session.Distance)
+	require.NotNil(t, lp.session.WhPerKm)
+	assert.Equal(t, 150.0, *lp.session.WhPerKm)
+}
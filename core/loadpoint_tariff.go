@@ -0,0 +1,110 @@
+package core
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/evcc-io/evcc/core/keys"
+)
+
+// Tariff zones
+const (
+	tariffZoneCheap   = "cheap"   // price below smart cost limit
+	tariffZoneDefault = "default" // any other price
+)
+
+// TariffCurrentConfig contains the max current applied while a tariff zone is active
+type TariffCurrentConfig struct {
+	Tariff     string  `mapstructure:"tariff"`
+	MaxCurrent float64 `mapstructure:"maxCurrent"`
+}
+
+// tariffZone returns the tariff zone classification
+func tariffZone(cheap bool) string {
+	if cheap {
+		return tariffZoneCheap
+	}
+	return tariffZoneDefault
+}
+
+// validateTariffMaxCurrent removes invalid tariff zone current limits
+func (lp *Loadpoint) validateTariffMaxCurrent() {
+	var res []TariffCurrentConfig
+
+	for _, tc := range lp.TariffMaxCurrent {
+		tc.Tariff = strings.ToLower(tc.Tariff)
+
+		if !slices.Contains([]string{tariffZoneCheap, tariffZoneDefault}, tc.Tariff) || tc.MaxCurrent <= 0 {
+			lp.log.WARN.Printf("invalid tariff max current: %s %.3gA", tc.Tariff, tc.MaxCurrent)
+			continue
+		}
+
+		res = append(res, tc)
+	}
+
+	lp.TariffMaxCurrent = res
+}
+
+// setTariffZone updates the active tariff zone and returns true if this changes the loadpoint's max current
+func (lp *Loadpoint) setTariffZone(zone string) bool {
+	if len(lp.TariffMaxCurrent) == 0 || zone == lp.tariffZone {
+		return false
+	}
+
+	prev, _ := lp.tariffMaxCurrent()
+	lp.tariffZone = zone
+	res, _ := lp.tariffMaxCurrent()
+
+	return res != prev
+}
+
+// tariffMaxCurrent returns the max current configured for the active tariff zone
+func (lp *Loadpoint) tariffMaxCurrent() (float64, bool) {
+	for _, tc := range lp.TariffMaxCurrent {
+		if tc.Tariff == lp.tariffZone {
+			return tc.MaxCurrent, true
+		}
+	}
+	return 0, false
+}
+
+// validateTariffCeiling disables invalid tariff ceiling configuration
+func (lp *Loadpoint) validateTariffCeiling() {
+	if lp.TariffCeiling < 0 {
+		lp.log.WARN.Printf("invalid tariff ceiling: %.3g", lp.TariffCeiling)
+		lp.TariffCeiling = 0
+	}
+
+	if lp.TariffCeilingHysteresis < 0 || lp.TariffCeilingHysteresis > lp.TariffCeiling {
+		lp.log.WARN.Printf("invalid tariff ceiling hysteresis: %.3g", lp.TariffCeilingHysteresis)
+		lp.TariffCeilingHysteresis = 0
+	}
+}
+
+// setTariffCeiling pauses charging while the grid price exceeds the tariff ceiling and resumes below
+// ceiling - hysteresis. Unknown prices keep the current state. Returns true if the pause state changed.
+func (lp *Loadpoint) setTariffCeiling(price float64, ok bool) bool {
+	if lp.TariffCeiling == 0 || !ok {
+		return false
+	}
+
+	pause := price > lp.TariffCeiling
+	if lp.tariffCeilingPause {
+		pause = price > lp.TariffCeiling-lp.TariffCeilingHysteresis
+	}
+
+	if pause == lp.tariffCeilingPause {
+		return false
+	}
+
+	if pause {
+		lp.log.WARN.Printf("tariff ceiling: price %.3f above %.3f, charging paused", price, lp.TariffCeiling)
+	} else {
+		lp.log.INFO.Printf("tariff ceiling: price %.3f, charging resumed", price)
+	}
+
+	lp.tariffCeilingPause = pause
+	lp.publish(keys.TariffCeilingPaused, pause)
+
+	return true
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTariffMaxCurrent(t *testing.T) {
+	lp := &Loadpoint{
+		log:        util.NewLogger("foo"),
+		maxCurrent: 16,
+		TariffMaxCurrent: []TariffCurrentConfig{
+			{Tariff: "Cheap", MaxCurrent: 32},
+			{Tariff: "peak", MaxCurrent: 10},
+		},
+	}
+
+	lp.validateTariffMaxCurrent()
+	assert.Equal(t, []TariffCurrentConfig{{Tariff: tariffZoneCheap, MaxCurrent: 32}}, lp.TariffMaxCurrent)
+
+	assert.Equal(t, 16.0, lp.effectiveMaxCurrent())
+
+	assert.True(t, lp.setTariffZone(tariffZone(true)))
+	assert.False(t, lp.setTariffZone(tariffZone(true)))
+	assert.Equal(t, 32.0, lp.effectiveMaxCurrent())
+
+	assert.True(t, lp.setTariffZone(tariffZone(false)))
+	assert.Equal(t, 16.0, lp.effectiveMaxCurrent())
+}
+
+func TestTariffCeiling(t *testing.T) {
+	lp := &Loadpoint{
+		log:                     util.NewLogger("foo"),
+		TariffCeiling:           0.5,
+		TariffCeilingHysteresis: 0.6,
+	}
+
+	lp.validateTariffCeiling()
+	assert.Equal(t, 0.0, lp.TariffCeilingHysteresis)
+
+	lp.TariffCeilingHysteresis = 0.1
+
+	for _, tc := range []struct {
+		price          float64
+		ok             bool
+		pause, changed bool
+	}{
+		{0.3, true, false, false},
+		{0.5, true, false, false},
+		{0.51, true, true, true},
+		{0.45, true, true, false}, // within hysteresis
+		{0.2, false, true, false}, // unknown price
+		{0.39, true, false, true},
+	} {
+		assert.Equal(t, tc.changed, lp.setTariffCeiling(tc.price, tc.ok), tc.price)
+		assert.Equal(t, tc.pause, lp.tariffCeilingPause, tc.price)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	evbus "github.com/asaskevich/EventBus"
 	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/planner"
 	"github.com/evcc-io/evcc/core/soc"
 	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/util"
@@ -33,7 +34,7 @@ func (n *Null) ChargingTime() (time.Duration, error) {
 	return 0, nil
 }
 
-func createChannels(t *testing.T) (chan util.Param, chan push.Event, chan *Loadpoint) {
+func createChannels(t testing.TB) (chan util.Param, chan push.Event, chan *Loadpoint) {
 	t.Helper()
 
 	uiChan := make(chan util.Param)
@@ -69,7 +70,7 @@ func attachChannels(lp *Loadpoint, uiChan chan util.Param, pushChan chan push.Ev
 	lp.lpChan = lpChan
 }
 
-func attachListeners(t *testing.T, lp *Loadpoint) {
+func attachListeners(t testing.TB, lp *Loadpoint) {
 	t.Helper()
 
 	Voltage = 230 // V
@@ -380,6 +381,57 @@ func TestPVHysteresisForStatusOtherThanC(t *testing.T) {
 	ctrl.Finish()
 }
 
+func TestSurplusHysteresis(t *testing.T) {
+	const dt = time.Minute
+	const phases = 3
+
+	tc := []struct {
+		enabled bool
+		site    float64
+		current float64
+	}{
+		// min power 1800W, hysteresis 300W
+		{false, -1800, 0},    // keep disabled below enable threshold
+		{false, -2100, minA}, // enable at min power + hysteresis
+		{true, 300, minA},    // keep enabled at min power - hysteresis
+		{true, 600, 0},       // disable below min power - hysteresis
+	}
+
+	for _, tc := range tc {
+		t.Log(tc)
+
+		clck := clock.NewMock()
+
+		Voltage = 100
+		lp := &Loadpoint{
+			log:               util.NewLogger("foo"),
+			clock:             clck,
+			minCurrent:        minA,
+			maxCurrent:        maxA,
+			phases:            phases,
+			measuredPhases:    phases,
+			status:            api.StatusB,
+			enabled:           tc.enabled,
+			SurplusHysteresis: 300,
+			Enable:            ThresholdConfig{Delay: dt},
+			Disable:           ThresholdConfig{Delay: dt},
+		}
+
+		// timer running, current unchanged
+		start := 0.0
+		if tc.enabled {
+			lp.status = api.StatusC
+			lp.chargeCurrent = minA
+			start = minA
+		}
+
+		assert.Equal(t, start, lp.pvMaxCurrent(api.ModePV, tc.site, false, false), "start")
+
+		clck.Add(dt + 1)
+		assert.Equal(t, tc.current, lp.pvMaxCurrent(api.ModePV, tc.site, false, false), "elapsed")
+	}
+}
+
 func TestDisableAndEnableAtTargetSoc(t *testing.T) {
 	clock := clock.NewMock()
 	ctrl := gomock.NewController(t)
@@ -763,3 +815,143 @@ func TestPVHysteresisAfterPhaseSwitch(t *testing.T) {
 		ctrl.Finish()
 	}
 }
+
+func TestLazyMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := api.NewMockCharger(ctrl)
+
+	// planner uses wall clock
+	clck := clock.NewMock()
+	clck.Set(time.Now())
+	Voltage = 230
+
+	lp := &Loadpoint{
+		log:           util.NewLogger("foo"),
+		bus:           evbus.New(),
+		clock:         clck,
+		charger:       charger,
+		chargeMeter:   &Null{}, // silence nil panics
+		chargeRater:   &Null{}, // silence nil panics
+		chargeTimer:   &Null{}, // silence nil panics
+		wakeUpTimer:   NewTimer(),
+		sessionEnergy: NewEnergyMetrics(),
+		planner:       planner.New(util.NewLogger("foo"), nil),
+		minCurrent:    minA,
+		maxCurrent:    maxA,
+		phases:        1,
+		status:        api.StatusC,
+		enabled:       true,
+		mode:          api.ModeLazy,
+	}
+
+	attachListeners(t, lp)
+
+	charger.EXPECT().Status().Return(api.StatusC, nil).AnyTimes()
+	charger.EXPECT().Enabled().DoAndReturn(func() (bool, error) { return lp.enabled, nil }).AnyTimes()
+
+	// no plan, no pv charging
+	charger.EXPECT().Enable(false).Return(nil)
+	lp.Update(-5000, false, false, false, 0, nil, nil)
+	assert.False(t, lp.enabled)
+
+	// 10kWh at 16A take 2.7h, start is deferred
+	lp.setPlanEnergy(clck.Now().Add(8*time.Hour), 10)
+	lp.Update(-5000, false, false, false, 0, nil, nil)
+	assert.False(t, lp.enabled)
+
+	// start charging at latest start
+	clck.Add(6 * time.Hour)
+	charger.EXPECT().MaxCurrent(int64(maxA)).Return(nil)
+	charger.EXPECT().Enable(true).Return(nil)
+	lp.Update(0, false, false, false, 0, nil, nil)
+	assert.True(t, lp.enabled)
+}
+
+func TestBoostMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := api.NewMockCharger(ctrl)
+
+	clck := clock.NewMock()
+	clck.Set(time.Now())
+
+	lp := &Loadpoint{
+		log:           util.NewLogger("foo"),
+		bus:           evbus.New(),
+		clock:         clck,
+		charger:       charger,
+		chargeMeter:   &Null{}, // silence nil panics
+		chargeRater:   &Null{}, // silence nil panics
+		chargeTimer:   &Null{}, // silence nil panics
+		wakeUpTimer:   NewTimer(),
+		sessionEnergy: NewEnergyMetrics(),
+		minCurrent:    minA,
+		maxCurrent:    maxA,
+		phases:        1,
+		status:        api.StatusC,
+		mode:          api.ModeBoost,
+		limitSoc:      80,
+		vehicleSoc:    90,
+	}
+
+	attachListeners(t, lp)
+
+	charger.EXPECT().Status().Return(api.StatusC, nil).AnyTimes()
+	charger.EXPECT().Enabled().DoAndReturn(func() (bool, error) { return lp.enabled, nil }).AnyTimes()
+
+	// charge at max current from grid ignoring limit soc
+	charger.EXPECT().MaxCurrent(int64(maxA)).Return(nil)
+	lp.Update(1000, false, false, false, 0, nil, nil)
+	assert.True(t, lp.enabled)
+
+	// pv mode disables immediately after boosting
+	lp.SetMode(api.ModePV)
+	assert.True(t, lp.pvTimer.Equal(elapsed))
+}
+
+func BenchmarkUpdate(b *testing.B) {
+	for _, bc := range []struct {
+		name      string
+		mode      api.ChargeMode
+		sitePower float64
+	}{
+		{"now", api.ModeNow, 0},
+		{"minpv", api.ModeMinPV, -3000},
+		{"pv", api.ModePV, -3000},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			ctrl := gomock.NewController(b)
+			charger := api.NewMockCharger(ctrl)
+
+			lp := &Loadpoint{
+				log:           util.NewLogger("foo"),
+				bus:           evbus.New(),
+				clock:         clock.NewMock(),
+				charger:       charger,
+				chargeMeter:   &Null{}, // silence nil panics
+				chargeRater:   &Null{}, // silence nil panics
+				chargeTimer:   &Null{}, // silence nil panics
+				wakeUpTimer:   NewTimer(),
+				sessionEnergy: NewEnergyMetrics(),
+				minCurrent:    minA,
+				maxCurrent:    maxA,
+				phases:        1,
+				status:        api.StatusC,
+				mode:          bc.mode,
+			}
+
+			attachListeners(b, lp)
+
+			charger.EXPECT().Status().Return(api.StatusC, nil).AnyTimes()
+			charger.EXPECT().Enabled().Return(true, nil).AnyTimes()
+			charger.EXPECT().Enable(gomock.Any()).Return(nil).AnyTimes()
+			charger.EXPECT().MaxCurrent(gomock.Any()).Return(nil).AnyTimes()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for range b.N {
+				lp.Update(bc.sitePower, false, false, false, 0, nil, nil)
+			}
+		})
+	}
+}
@@ -99,7 +99,7 @@ func (lp *Loadpoint) selectVehicleByID(id string) api.Vehicle {
 }
 
 // setActiveVehicle assigns currently active vehicle, configures soc estimator
-// and adds an odometer task
+// and adds odometer and on-board charger limit tasks
 func (lp *Loadpoint) setActiveVehicle(v api.Vehicle) {
 	lp.vmu.Lock()
 
@@ -121,6 +121,8 @@ func (lp *Loadpoint) setActiveVehicle(v api.Vehicle) {
 		lp.log.INFO.Printf("vehicle updated: %s -> %s", from, to)
 	}
 
+	lp.vehicleOBCLimit = 0
+
 	if v != nil {
 		lp.socUpdated = time.Time{}
 
@@ -138,6 +140,7 @@ func (lp *Loadpoint) setActiveVehicle(v api.Vehicle) {
 		}
 
 		lp.addTask(lp.vehicleOdometer)
+		lp.addTask(lp.vehicleOBCLimitUpdate)
 
 		lp.progress.Reset()
 	} else {
@@ -147,6 +150,9 @@ func (lp *Loadpoint) setActiveVehicle(v api.Vehicle) {
 		lp.publish(keys.VehicleOdometer, 0.0)
 	}
 
+	// new vehicle is not known to be offline
+	lp.updateVehicleOffline()
+
 	// re-publish vehicle settings
 	lp.publish(keys.PhasesActive, lp.ActivePhases())
 	lp.unpublishVehicle()
@@ -302,6 +308,18 @@ func (lp *Loadpoint) vehicleOdometer() {
 	}
 }
 
+// vehicleOBCLimitUpdate updates the vehicle's on-board charger current limit
+func (lp *Loadpoint) vehicleOBCLimitUpdate() {
+	if vs, ok := lp.GetVehicle().(api.OnBoardChargerLimit); ok {
+		if limit, err := vs.OBCLimit(); err == nil {
+			lp.log.DEBUG.Printf("vehicle on-board charger limit: %.1fA", limit)
+			lp.vehicleOBCLimit = limit
+		} else if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("vehicle on-board charger limit: %v", err)
+		}
+	}
+}
+
 // vehicleClimatePollAllowed determines if polling depending on mode and connection status
 func (lp *Loadpoint) vehicleClimatePollAllowed() bool {
 	switch {
@@ -316,8 +334,8 @@ func (lp *Loadpoint) vehicleClimatePollAllowed() bool {
 
 // vehicleSocPollAllowed validates charging state against polling mode
 func (lp *Loadpoint) vehicleSocPollAllowed() bool {
-	// always update soc when charging
-	if lp.charging() {
+	// always update soc when charging unless the charger limits the vehicle soc
+	if lp.charging() && !lp.vehicleSocLimitActive() {
 		return true
 	}
 
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/core/keys"
+)
+
+// validateVehicleOffline validates the vehicle offline current limit
+func (lp *Loadpoint) validateVehicleOffline() {
+	if lp.VehicleOfflineCurrent < 0 {
+		lp.log.WARN.Printf("invalid vehicle offline current: %.3gA", lp.VehicleOfflineCurrent)
+		lp.VehicleOfflineCurrent = 0
+	}
+
+	if lp.VehicleOfflineAfter <= 0 {
+		lp.VehicleOfflineAfter = 5 * time.Minute
+	}
+}
+
+// updateVehicleOffline updates if the vehicle api has been unreachable for longer than vehicleOfflineAfter
+func (lp *Loadpoint) updateVehicleOffline() {
+	if lp.VehicleOfflineCurrent <= 0 {
+		return
+	}
+
+	unreachable := lp.socEstimator != nil && lp.socEstimator.Unreachable()
+
+	switch {
+	case !unreachable:
+		lp.vehicleUnreachable = time.Time{}
+	case lp.vehicleUnreachable.IsZero():
+		lp.vehicleUnreachable = lp.clock.Now()
+	}
+
+	offline := unreachable && lp.clock.Since(lp.vehicleUnreachable) >= lp.VehicleOfflineAfter
+	if offline == lp.vehicleOffline {
+		return
+	}
+
+	if offline {
+		lp.log.WARN.Printf("vehicle offline for %v: limiting current to %.3gA", lp.VehicleOfflineAfter, lp.VehicleOfflineCurrent)
+	} else {
+		lp.log.INFO.Println("vehicle online: removing current limit")
+	}
+
+	lp.vehicleOffline = offline
+	lp.publish(keys.VehicleOffline, offline)
+}
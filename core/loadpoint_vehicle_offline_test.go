@@ -0,0 +1,61 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/soc"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVehicleOffline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clck := clock.NewMock()
+
+	vehicle := api.NewMockVehicle(ctrl)
+	vehicle.EXPECT().Capacity().Return(50.0).AnyTimes()
+	vehicle.EXPECT().OnIdentified().Return(api.ActionConfig{}).AnyTimes()
+
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.clock = clck
+	lp.charger = api.NewMockCharger(ctrl)
+	lp.vehicle = vehicle
+	lp.socEstimator = soc.NewEstimator(util.NewLogger("foo"), lp.charger, vehicle, false)
+	lp.VehicleOfflineCurrent = 8
+	lp.validateVehicleOffline()
+	assert.Equal(t, 5*time.Minute, lp.VehicleOfflineAfter)
+
+	update := func(err error) {
+		vehicle.EXPECT().Soc().Return(80.0, err)
+		_, _ = lp.socEstimator.Soc(0)
+		lp.updateVehicleOffline()
+	}
+
+	update(nil)
+	assert.Equal(t, 16.0, lp.effectiveMaxCurrent())
+
+	// unreachable
+	update(errors.New("timeout"))
+	assert.Equal(t, 16.0, lp.effectiveMaxCurrent())
+
+	clck.Add(5 * time.Minute)
+	update(errors.New("timeout"))
+	assert.True(t, lp.vehicleOffline)
+	assert.Equal(t, 8.0, lp.effectiveMaxCurrent())
+
+	// reachable again
+	update(nil)
+	assert.False(t, lp.vehicleOffline)
+	assert.Equal(t, 16.0, lp.effectiveMaxCurrent())
+
+	// must retry is not offline
+	update(api.ErrMustRetry)
+	clck.Add(10 * time.Minute)
+	update(api.ErrMustRetry)
+	assert.False(t, lp.vehicleOffline)
+}
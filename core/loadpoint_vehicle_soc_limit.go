@@ -0,0 +1,36 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// syncVehicleSocLimit writes the effective limit soc to chargers limiting the vehicle soc.
+// The limit is written once per session and when it changes.
+func (lp *Loadpoint) syncVehicleSocLimit() {
+	if _, ok := lp.charger.(api.VehicleSocLimiter); !ok || !lp.connected() {
+		lp.vehicleSocLimit = 0
+		return
+	}
+
+	limit := int64(lp.effectiveLimitSoc())
+	if limit == lp.vehicleSocLimit {
+		return
+	}
+
+	if err := lp.chargerSetVehicleSocLimit(limit); err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("vehicle soc limit: %v", err)
+		}
+		return
+	}
+
+	lp.log.DEBUG.Printf("vehicle soc limit: %d%%", limit)
+	lp.vehicleSocLimit = limit
+}
+
+// vehicleSocLimitActive returns if the charger limits the vehicle soc
+func (lp *Loadpoint) vehicleSocLimitActive() bool {
+	return lp.vehicleSocLimit > 0
+}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type socLimitCharger struct {
+	*api.MockCharger
+	limit  int64
+	writes int
+}
+
+func (c *socLimitCharger) VehicleSocLimit() (int64, error) {
+	return c.limit, nil
+}
+
+func (c *socLimitCharger) SetVehicleSocLimit(soc int64) error {
+	c.limit = soc
+	c.writes++
+	return nil
+}
+
+func TestSyncVehicleSocLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	charger := &socLimitCharger{MockCharger: api.NewMockCharger(ctrl)}
+
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.charger = charger
+	lp.status = api.StatusB
+	lp.limitSoc = 80
+
+	// written once per session
+	lp.syncVehicleSocLimit()
+	lp.syncVehicleSocLimit()
+	assert.Equal(t, int64(80), charger.limit)
+	assert.Equal(t, 1, charger.writes)
+	assert.True(t, lp.vehicleSocLimitActive())
+
+	// rewritten on change
+	lp.limitSoc = 90
+	lp.syncVehicleSocLimit()
+	assert.Equal(t, int64(90), charger.limit)
+	assert.Equal(t, 2, charger.writes)
+
+	// reset on disconnect
+	lp.status = api.StatusA
+	lp.syncVehicleSocLimit()
+	assert.False(t, lp.vehicleSocLimitActive())
+
+	// not written in dry-run mode
+	lp.DryRun = true
+	lp.status = api.StatusB
+	lp.syncVehicleSocLimit()
+	assert.Equal(t, 2, charger.writes)
+}
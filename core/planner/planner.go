@@ -1,6 +1,7 @@
 package planner
 
 import (
+	"math"
 	"slices"
 	"time"
 
@@ -11,9 +12,25 @@ import (
 
 // Planner plans a series of charging slots for a given (variable) tariff
 type Planner struct {
-	log    *util.Logger
-	clock  clock.Clock // mockable time
-	tariff api.Tariff
+	log     *util.Logger
+	clock   clock.Clock // mockable time
+	tariff  api.Tariff
+	windows bool // prefer single contiguous window
+	exclude func(time.Time) bool
+}
+
+// WithExclusion prevents the planner from using slots starting at excluded times
+func WithExclusion(exclude func(time.Time) bool) func(t *Planner) {
+	return func(t *Planner) {
+		t.exclude = exclude
+	}
+}
+
+// WithCheapWindows makes the planner prefer a single contiguous charging window of lowest total cost
+func WithCheapWindows(enable bool) func(t *Planner) {
+	return func(t *Planner) {
+		t.windows = enable
+	}
 }
 
 // New creates a price planner
@@ -31,6 +48,11 @@ func New(log *util.Logger, tariff api.Tariff, opt ...func(t *Planner)) *Planner
 	return p
 }
 
+// excluded checks if the slot must not be used for charging
+func (t *Planner) excluded(r api.Rate) bool {
+	return t.exclude != nil && t.exclude(r.Start)
+}
+
 // plan creates a lowest-cost plan or required duration.
 // It MUST already established that
 // - rates are sorted in ascending order by cost and descending order by start time (prefer late slots)
@@ -44,6 +66,11 @@ func (t *Planner) plan(rates api.Rates, requiredDuration time.Duration, targetTi
 			continue
 		}
 
+		// slot not available
+		if t.excluded(source) {
+			continue
+		}
+
 		// adjust slot start and end
 		slot := source
 		if slot.Start.Before(t.clock.Now()) {
@@ -82,6 +109,96 @@ func (t *Planner) plan(rates api.Rates, requiredDuration time.Duration, targetTi
 	return plan
 }
 
+// windowPlan creates the lowest-cost contiguous plan of required duration.
+// It MUST already established that
+// - rates are sorted in ascending order by start time
+// - target time and required duration are before end of rates
+func (t *Planner) windowPlan(rates api.Rates, requiredDuration time.Duration, targetTime time.Time) api.Rates {
+	now := t.clock.Now()
+
+	// candidate windows start or end at slot boundaries
+	candidates := []time.Time{now, targetTime.Add(-requiredDuration)}
+	for _, r := range rates {
+		candidates = append(candidates, r.Start, r.End.Add(-requiredDuration))
+	}
+
+	var (
+		bestStart time.Time
+		bestCost  = math.Inf(1)
+	)
+
+	for _, start := range candidates {
+		end := start.Add(requiredDuration)
+		if start.Before(now) || end.After(targetTime) {
+			continue
+		}
+
+		var (
+			cost    float64
+			covered time.Duration
+		)
+		for _, r := range rates {
+			if t.excluded(r) {
+				continue
+			}
+
+			from, to := r.Start, r.End
+			if from.Before(start) {
+				from = start
+			}
+			if to.After(end) {
+				to = end
+			}
+			if overlap := to.Sub(from); overlap > 0 {
+				cost += float64(overlap) * r.Price
+				covered += overlap
+			}
+		}
+
+		// window must not span gaps like excluded slots
+		if covered < requiredDuration {
+			continue
+		}
+
+		// prefer late windows
+		if cost < bestCost || cost == bestCost && start.After(bestStart) {
+			bestStart, bestCost = start, cost
+		}
+	}
+
+	if bestStart.IsZero() {
+		return nil
+	}
+
+	return t.continuousPlan(rates, bestStart, bestStart.Add(requiredDuration))
+}
+
+// simplePlan creates a single slot ending at target time, moved before excluded times if possible
+func (t *Planner) simplePlan(requiredDuration time.Duration, targetTime time.Time) api.Rates {
+	end := targetTime
+
+	// find latest free window with minute resolution
+	if t.exclude != nil {
+		for ts := end; end.Sub(ts) < requiredDuration; {
+			ts = ts.Add(-time.Minute)
+			if ts.Before(t.clock.Now()) {
+				end = targetTime
+				break
+			}
+			if t.exclude(ts) {
+				end = ts
+			}
+		}
+	}
+
+	return api.Rates{
+		api.Rate{
+			Start: end.Add(-requiredDuration),
+			End:   end,
+		},
+	}
+}
+
 // Plan creates a continuous emergency charging plan
 func (t *Planner) continuousPlan(rates api.Rates, start, end time.Time) api.Rates {
 	rates.Sort()
@@ -148,12 +265,7 @@ func (t *Planner) Plan(requiredDuration time.Duration, targetTime time.Time) (ap
 	}
 
 	// simplePlan only considers time, but not cost
-	simplePlan := api.Rates{
-		api.Rate{
-			Start: latestStart,
-			End:   targetTime,
-		},
-	}
+	simplePlan := t.simplePlan(requiredDuration, targetTime)
 
 	// target charging without tariff or late start
 	if t.tariff == nil {
@@ -175,9 +287,6 @@ func (t *Planner) Plan(requiredDuration time.Duration, targetTime time.Time) (ap
 	// rates are by default sorted by date, oldest to newest
 	last := rates[len(rates)-1].End
 
-	// sort rates by price and time
-	slices.SortStableFunc(rates, sortByCost)
-
 	// reduce planning horizon to available rates
 	if targetTime.After(last) {
 		// there is enough time for charging after end of current rates
@@ -194,6 +303,17 @@ func (t *Planner) Plan(requiredDuration time.Duration, targetTime time.Time) (ap
 		requiredDuration -= durationAfterRates
 	}
 
+	if t.windows {
+		if plan := t.windowPlan(rates, requiredDuration, targetTime); plan != nil {
+			return plan, nil
+		}
+
+		t.log.DEBUG.Printf("no contiguous window available- falling back to cheapest slots")
+	}
+
+	// sort rates by price and time
+	slices.SortStableFunc(rates, sortByCost)
+
 	plan := t.plan(rates, requiredDuration, targetTime)
 
 	// sort plan by time
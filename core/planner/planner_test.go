@@ -307,3 +307,143 @@ func TestContinuousPlanOutsideRates(t *testing.T) {
 	// 3-slot plan
 	assert.Len(t, plan, 1)
 }
+
+func TestCheapWindowPlan(t *testing.T) {
+	clock := clock.NewMock()
+	ctrl := gomock.NewController(t)
+
+	trf := api.NewMockTariff(ctrl)
+	trf.EXPECT().Rates().AnyTimes().Return(rates([]float64{20, 60, 10, 80, 40, 90}, clock.Now(), time.Hour), nil)
+
+	p := &Planner{
+		log:     util.NewLogger("foo"),
+		clock:   clock,
+		tariff:  trf,
+		windows: true,
+	}
+
+	plan, err := p.Plan(2*time.Hour, clock.Now().Add(6*time.Hour))
+	require.NoError(t, err)
+
+	// contiguous 60+10 window instead of cheapest 20+10 slots
+	assert.Equal(t, 2*time.Hour, Duration(plan))
+	assert.Equal(t, clock.Now().Add(time.Hour), Start(plan))
+	assert.Equal(t, 35.0, AverageCost(plan))
+
+	plan, err = p.Plan(90*time.Minute, clock.Now().Add(6*time.Hour))
+	require.NoError(t, err)
+
+	// window aligned to end of cheapest slot
+	assert.Equal(t, 90*time.Minute, Duration(plan))
+	assert.Equal(t, clock.Now().Add(90*time.Minute), Start(plan))
+}
+
+func TestCheapWindowPlanExclusion(t *testing.T) {
+	clock := clock.NewMock()
+	ctrl := gomock.NewController(t)
+
+	trf := api.NewMockTariff(ctrl)
+	trf.EXPECT().Rates().AnyTimes().Return(rates([]float64{20, 60, 10, 80, 40, 90}, clock.Now(), time.Hour), nil)
+
+	p := &Planner{
+		log:     util.NewLogger("foo"),
+		clock:   clock,
+		tariff:  trf,
+		windows: true,
+	}
+
+	// exclude the cheapest slot
+	WithExclusion(func(ts time.Time) bool {
+		return ts.Equal(clock.Now().Add(2 * time.Hour))
+	})(p)
+
+	plan, err := p.Plan(2*time.Hour, clock.Now().Add(6*time.Hour))
+	require.NoError(t, err)
+
+	// window must not span the excluded slot
+	assert.Equal(t, 2*time.Hour, Duration(plan))
+	assert.Equal(t, clock.Now(), Start(plan))
+	assert.Equal(t, 40.0, AverageCost(plan))
+}
+
+func TestPlanExclusionAtEndOfRates(t *testing.T) {
+	clock := clock.NewMock()
+	ctrl := gomock.NewController(t)
+
+	trf := api.NewMockTariff(ctrl)
+	trf.EXPECT().Rates().AnyTimes().Return(rates([]float64{20, 60, 10, 80, 40, 90}, clock.Now(), time.Hour), nil)
+
+	// exclude the final slots
+	exclude := func(ts time.Time) bool {
+		return !ts.Before(clock.Now().Add(4 * time.Hour))
+	}
+
+	for _, windows := range []bool{false, true} {
+		p := &Planner{
+			log:     util.NewLogger("foo"),
+			clock:   clock,
+			tariff:  trf,
+			windows: windows,
+		}
+		WithExclusion(exclude)(p)
+
+		plan, err := p.Plan(2*time.Hour, clock.Now().Add(6*time.Hour))
+		require.NoError(t, err)
+
+		// plan must not be moved into the excluded slots
+		assert.Equal(t, 2*time.Hour, Duration(plan))
+		for _, slot := range plan {
+			assert.False(t, exclude(slot.Start), "slot %v excluded", slot)
+		}
+	}
+}
+
+func TestNilTariffExclusion(t *testing.T) {
+	clock := clock.NewMock()
+
+	p := &Planner{
+		log:   util.NewLogger("foo"),
+		clock: clock,
+	}
+
+	WithExclusion(func(ts time.Time) bool {
+		return !ts.Before(clock.Now().Add(5*time.Hour)) && ts.Before(clock.Now().Add(7*time.Hour))
+	})(p)
+
+	plan, err := p.Plan(2*time.Hour, clock.Now().Add(6*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, api.Rates{
+		{
+			Start: clock.Now().Add(3 * time.Hour),
+			End:   clock.Now().Add(5 * time.Hour),
+		},
+	}, plan, "expected simple plan before excluded times")
+}
+
+func TestCheapWindowPlanFallback(t *testing.T) {
+	clock := clock.NewMock()
+	ctrl := gomock.NewController(t)
+
+	trf := api.NewMockTariff(ctrl)
+	trf.EXPECT().Rates().AnyTimes().Return(rates([]float64{20, 60, 10, 80, 40, 90}, clock.Now(), time.Hour), nil)
+
+	p := &Planner{
+		log:     util.NewLogger("foo"),
+		clock:   clock,
+		tariff:  trf,
+		windows: true,
+	}
+
+	// exclude every other slot, no contiguous window fits
+	WithExclusion(func(ts time.Time) bool {
+		return clock.Until(ts)/time.Hour%2 == 1
+	})(p)
+
+	plan, err := p.Plan(2*time.Hour, clock.Now().Add(6*time.Hour))
+	require.NoError(t, err)
+
+	// fall back to cheapest available slots
+	assert.Equal(t, 2*time.Hour, Duration(plan))
+	assert.Equal(t, clock.Now(), Start(plan))
+	assert.Equal(t, 15.0, AverageCost(plan))
+}
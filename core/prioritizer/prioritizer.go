@@ -2,30 +2,99 @@ package prioritizer
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/util"
 )
 
+// SharingMode determines how PV surplus is shared between loadpoints
+type SharingMode string
+
+const (
+	SharingPriority SharingMode = "priority" // higher priority loadpoints take power from lower priority loadpoints
+	SharingEqual    SharingMode = "equal"    // loadpoints converge to equal charge power
+	SharingSoc      SharingMode = "soc"      // loadpoints converge to charge power proportional to missing soc
+)
+
+// ParseSharingMode parses the sharing mode, defaulting to priority
+func ParseSharingMode(s string) (SharingMode, error) {
+	switch m := SharingMode(strings.ToLower(s)); m {
+	case "":
+		return SharingPriority, nil
+	case SharingPriority, SharingEqual, SharingSoc:
+		return m, nil
+	default:
+		return "", fmt.Errorf("invalid sharing mode: %s", s)
+	}
+}
+
+type demand struct {
+	flexibility float64 // power that can be released
+	power       float64 // current charge power
+	weight      float64 // relative share of total charge power
+}
+
 type Prioritizer struct {
 	log    *util.Logger
-	demand map[loadpoint.API]float64
+	mode   SharingMode
+	demand map[loadpoint.API]demand
+}
+
+// WithSharingMode sets the sharing mode
+func WithSharingMode(mode SharingMode) func(p *Prioritizer) {
+	return func(p *Prioritizer) {
+		p.mode = mode
+	}
 }
 
-func New(log *util.Logger) *Prioritizer {
-	return &Prioritizer{
+func New(log *util.Logger, opt ...func(p *Prioritizer)) *Prioritizer {
+	p := &Prioritizer{
 		log:    log,
-		demand: make(map[loadpoint.API]float64),
+		mode:   SharingPriority,
+		demand: make(map[loadpoint.API]demand),
 	}
+
+	for _, o := range opt {
+		o(p)
+	}
+
+	return p
+}
+
+// weight returns the loadpoint's relative share of total charge power
+func (p *Prioritizer) weight(lp loadpoint.API) float64 {
+	if p.mode != SharingSoc {
+		return 1
+	}
+
+	// unknown soc
+	soc := lp.GetVehicleSoc()
+	if soc <= 0 {
+		return 50
+	}
+
+	return max(1, 100-soc)
 }
 
 func (p *Prioritizer) UpdateChargePowerFlexibility(lp loadpoint.API) {
 	if power := lp.GetChargePowerFlexibility(); power >= 0 {
-		p.demand[lp] = power
+		d := demand{flexibility: power}
+
+		if p.mode != SharingPriority {
+			d.power = lp.GetChargePower()
+			d.weight = p.weight(lp)
+		}
+
+		p.demand[lp] = d
 	}
 }
 
 func (p *Prioritizer) GetChargePowerFlexibility(lp loadpoint.API) float64 {
+	if p.mode != SharingPriority {
+		return p.sharedChargePowerFlexibility(lp)
+	}
+
 	prio := lp.EffectivePriority()
 
 	var (
@@ -33,10 +102,10 @@ func (p *Prioritizer) GetChargePowerFlexibility(lp loadpoint.API) float64 {
 		msg      string
 	)
 
-	for lp, power := range p.demand {
-		if lp.EffectivePriority() < prio && power > 0 {
-			reduceBy += power
-			msg += fmt.Sprintf("%.0fW from %s at prio %d, ", power, lp.Title(), lp.EffectivePriority())
+	for lp, d := range p.demand {
+		if lp.EffectivePriority() < prio && d.flexibility > 0 {
+			reduceBy += d.flexibility
+			msg += fmt.Sprintf("%.0fW from %s at prio %d, ", d.flexibility, lp.Title(), lp.EffectivePriority())
 		}
 	}
 
@@ -46,3 +115,34 @@ func (p *Prioritizer) GetChargePowerFlexibility(lp loadpoint.API) float64 {
 
 	return reduceBy
 }
+
+// sharedChargePowerFlexibility returns the power the loadpoint may take from each other loadpoint
+// until both loadpoints' charge power is proportional to their weights
+func (p *Prioritizer) sharedChargePowerFlexibility(lp loadpoint.API) float64 {
+	power := lp.GetChargePower()
+	weight := p.weight(lp)
+
+	var (
+		reduceBy float64
+		msg      string
+	)
+
+	for other, d := range p.demand {
+		if other == lp || d.flexibility <= 0 {
+			continue
+		}
+
+		// (power + x) / weight == (d.power - x) / d.weight
+		if x := (weight*d.power - d.weight*power) / (weight + d.weight); x > 0 {
+			x = min(x, d.flexibility)
+			reduceBy += x
+			msg += fmt.Sprintf("%.0fW from %s, ", x, other.Title())
+		}
+	}
+
+	if p.log != nil && reduceBy > 0 {
+		p.log.DEBUG.Printf("lp %s gets additional %stotal %.0fW (%s sharing)\n", lp.Title(), msg, reduceBy, p.mode)
+	}
+
+	return reduceBy
+}
@@ -38,3 +38,67 @@ func TestPrioritzer(t *testing.T) {
 	p.UpdateChargePowerFlexibility(lo)
 	assert.Equal(t, 0.0, p.GetChargePowerFlexibility(hi))
 }
+
+func TestSharingEqual(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	p := New(nil, WithSharingMode(SharingEqual))
+
+	a := loadpoint.NewMockAPI(ctrl)
+	a.EXPECT().Title().AnyTimes()
+	a.EXPECT().GetChargePower().Return(4e3).AnyTimes()
+	a.EXPECT().GetChargePowerFlexibility().Return(4e3)
+
+	b := loadpoint.NewMockAPI(ctrl)
+	b.EXPECT().Title().AnyTimes()
+	b.EXPECT().GetChargePower().Return(2e3).AnyTimes()
+	b.EXPECT().GetChargePowerFlexibility().Return(2e3)
+
+	p.UpdateChargePowerFlexibility(a)
+	p.UpdateChargePowerFlexibility(b)
+
+	// lower power loadpoint takes half of the difference
+	assert.Equal(t, 1e3, p.GetChargePowerFlexibility(b))
+	assert.Equal(t, 0.0, p.GetChargePowerFlexibility(a))
+}
+
+func TestSharingSoc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	p := New(nil, WithSharingMode(SharingSoc))
+
+	lo := loadpoint.NewMockAPI(ctrl)
+	lo.EXPECT().Title().AnyTimes()
+	lo.EXPECT().GetVehicleSoc().Return(20.0).AnyTimes() // weight 80
+	lo.EXPECT().GetChargePower().Return(3e3).AnyTimes()
+	lo.EXPECT().GetChargePowerFlexibility().Return(3e3)
+
+	hi := loadpoint.NewMockAPI(ctrl)
+	hi.EXPECT().Title().AnyTimes()
+	hi.EXPECT().GetVehicleSoc().Return(80.0).AnyTimes() // weight 20
+	hi.EXPECT().GetChargePower().Return(3e3).AnyTimes()
+	hi.EXPECT().GetChargePowerFlexibility().Return(3e3)
+
+	p.UpdateChargePowerFlexibility(lo)
+	p.UpdateChargePowerFlexibility(hi)
+
+	// 6kW total split 80:20
+	assert.Equal(t, 1.8e3, p.GetChargePowerFlexibility(lo))
+	assert.Equal(t, 0.0, p.GetChargePowerFlexibility(hi))
+}
+
+func TestParseSharingMode(t *testing.T) {
+	for in, mode := range map[string]SharingMode{
+		"":         SharingPriority,
+		"priority": SharingPriority,
+		"Equal":    SharingEqual,
+		"soc":      SharingSoc,
+	} {
+		m, err := ParseSharingMode(in)
+		assert.NoError(t, err)
+		assert.Equal(t, mode, m)
+	}
+
+	_, err := ParseSharingMode("foo")
+	assert.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package session
 
 import (
+	"time"
+
 	"github.com/evcc-io/evcc/util"
 	"gorm.io/gorm"
 )
@@ -53,6 +55,16 @@ func (s *DB) Sessions() (Sessions, error) {
 	return res, tx.Error
 }
 
+// PreviousOdometer returns the odometer of the vehicle's most recent session created before the given time
+func (s *DB) PreviousOdometer(vehicle string, before time.Time) (float64, bool) {
+	var res Session
+	if tx := s.db.Limit(1).Order("created DESC").Find(&res, "vehicle = ? AND odometer IS NOT NULL AND created < ?", vehicle, before); tx.Error != nil || tx.RowsAffected == 0 {
+		return 0, false
+	}
+
+	return *res.Odometer, true
+}
+
 func (s *DB) ClosePendingSessionsInHistory(chargeMeterTotal float64) error {
 	var res Sessions
 	if tx := s.db.Find(&res, map[string]interface{}{"finished": "0001-01-01 00:00:00+00:00", "Loadpoint": s.name}); tx.Error != nil {
@@ -20,13 +20,15 @@ import (
 
 // Session is a single charging session
 type Session struct {
-	ID              uint           `json:"id" csv:"-" gorm:"primarykey"`
-	Created         time.Time      `json:"created"`
+	ID              uint           `json:"id" csv:"ID" gorm:"primarykey"`
+	Created         time.Time      `json:"created" gorm:"index"`
 	Finished        time.Time      `json:"finished"`
 	Loadpoint       string         `json:"loadpoint"`
 	Identifier      string         `json:"identifier"`
 	Vehicle         string         `json:"vehicle"`
 	Odometer        *float64       `json:"odometer" format:"int"`
+	Distance        *float64       `json:"distance" csv:"Distance (km)" gorm:"column:distance_km" format:"int"`
+	WhPerKm         *float64       `json:"whPerKm" csv:"Consumption (Wh/km)" gorm:"column:wh_per_km" format:"int"`
 	MeterStart      *float64       `json:"meterStart" csv:"Meter Start (kWh)" gorm:"column:meter_start_kwh"`
 	MeterStop       *float64       `json:"meterStop" csv:"Meter Stop (kWh)" gorm:"column:meter_end_kwh"`
 	ChargedEnergy   float64        `json:"chargedEnergy" csv:"Charged Energy (kWh)" gorm:"column:charged_kwh"`
@@ -35,6 +37,7 @@ type Session struct {
 	Price           *float64       `json:"price" csv:"Price" gorm:"column:price"`
 	PricePerKWh     *float64       `json:"pricePerKWh" csv:"Price/kWh" gorm:"column:price_per_kwh"`
 	Co2PerKWh       *float64       `json:"co2PerKWh" csv:"CO2/kWh (gCO2eq)" gorm:"column:co2_per_kwh"`
+	Co2             *float64       `json:"co2" csv:"CO2 (gCO2eq)" gorm:"column:co2" format:"int"`
 }
 
 // Sessions is a list of sessions
@@ -42,7 +45,7 @@ type Sessions []Session
 
 var _ api.CsvWriter = (*Sessions)(nil)
 
-func (t *Sessions) writeHeader(ctx context.Context, ww *csv.Writer) error {
+func writeHeader(ctx context.Context, ww *csv.Writer) error {
 	localizer := locale.Localizer
 	if val := ctx.Value(locale.Locale).(string); val != "" {
 		localizer = i18n.NewLocalizer(locale.Bundle, val, locale.Language)
@@ -92,7 +95,7 @@ func formatValue(mp *message.Printer, value any, digits int) string {
 	}
 }
 
-func (t *Sessions) writeRow(ww *csv.Writer, mp *message.Printer, r Session) error {
+func writeRow(ww *csv.Writer, mp *message.Printer, r Session) error {
 	var row []string
 	for _, f := range structs.Fields(r) {
 		if f.Tag("csv") == "-" {
@@ -114,6 +117,18 @@ func (t *Sessions) writeRow(ww *csv.Writer, mp *message.Printer, r Session) erro
 
 // WriteCsv implements the api.CsvWriter interface
 func (t *Sessions) WriteCsv(ctx context.Context, w io.Writer) error {
+	return WriteCsvFunc(ctx, w, func(yield func(Session) error) error {
+		for _, r := range *t {
+			if err := yield(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WriteCsvFunc writes the sessions produced by rows as CSV without collecting them in memory
+func WriteCsvFunc(ctx context.Context, w io.Writer, rows func(yield func(Session) error) error) error {
 	if _, err := w.Write([]byte{0xEF, 0xBB, 0xBF}); err != nil {
 		return err
 	}
@@ -136,15 +151,15 @@ func (t *Sessions) WriteCsv(ctx context.Context, w io.Writer) error {
 		ww.Comma = ';'
 	}
 
-	if err := t.writeHeader(ctx, ww); err != nil {
+	if err := writeHeader(ctx, ww); err != nil {
 		return err
 	}
 
 	mp := message.NewPrinter(tag)
-	for _, r := range *t {
-		if err := t.writeRow(ww, mp, r); err != nil {
-			return err
-		}
+	if err := rows(func(r Session) error {
+		return writeRow(ww, mp, r)
+	}); err != nil {
+		return err
 	}
 
 	ww.Flush()
@@ -71,12 +71,34 @@ type Site struct {
 	Meters                            MetersConfig // Meter references
 	MaxGridSupplyWhileBatteryCharging float64      `mapstructure:"maxGridSupplyWhileBatteryCharging"` // ignore battery charging if AC consumption is above this value
 
+	Maintenance *MaintenanceConfig `mapstructure:"maintenance"` // Scheduled maintenance window
+
+	BatteryProtection *BatteryProtectionConfig `mapstructure:"batteryProtection"` // Home battery soc not used for charging
+	BatteryBoost      *BatteryBoostConfig      `mapstructure:"batteryBoost"`      // Home battery soc discharged for pv charging
+
+	GridProtection *GridProtectionConfig `mapstructure:"gridProtection"` // Grid protection relay stopping all loadpoints on fault
+
+	GridFrequency    float64 `mapstructure:"gridFrequency"`    // Nominal grid frequency in Hz
+	FrequencyControl bool    `mapstructure:"frequencyControl"` // Pause charging while grid frequency deviates from nominal
+
+	DryRun bool `mapstructure:"dryRun"` // Log charger writes of all loadpoints instead of executing them
+
+	SharingMode string `mapstructure:"sharingMode"` // PV surplus sharing between loadpoints (priority, equal, soc)
+
+	TemperatureSensor string `mapstructure:"temperatureSensor"` // Ambient temperature meter reference for charge current derating
+
+	MaxGridPower float64 `mapstructure:"maxGridPower"` // Grid capacity allocated to loadpoints by priority
+	DemandLimit  float64 `mapstructure:"demandLimit"`  // Limit of the 15 minute average grid demand
+
 	// meters
 	gridMeter     api.Meter   // Grid usage meter
 	pvMeters      []api.Meter // PV generation meters
 	batteryMeters []api.Meter // Battery charging meters
 	auxMeters     []api.Meter // Auxiliary meters
 
+	temperatureSensor   api.Temperature // Ambient temperature
+	temperatureDerating float64         // Max current reduction due to ambient temperature, 1 stops charging
+
 	// battery settings
 	prioritySoc             float64 // prefer battery up to this Soc
 	bufferSoc               float64 // continue charging on battery above this Soc
@@ -88,6 +110,7 @@ type Site struct {
 	coordinator *coordinator.Coordinator // Vehicles
 	prioritizer *prioritizer.Prioritizer // Power budgets
 	stats       *Stats                   // Stats
+	exporter    Exporter                 // Device metrics export
 
 	// cached state
 	gridPower    float64         // Grid power
@@ -96,6 +119,14 @@ type Site struct {
 	batterySoc   float64         // Battery soc
 	batteryMode  api.BatteryMode // Battery mode
 
+	frequencyDeviation  bool // Grid frequency out of range
+	batteryProtected    bool // Battery below protection soc
+	gridProtectionFault bool // Grid protection relay fault not yet confirmed
+
+	demand demandWindow // 15 minute grid demand
+
+	metersErr error // result of the last site meter update
+
 	publishCache map[string]any // store last published values to avoid unnecessary republishing
 }
 
@@ -119,6 +150,42 @@ func NewSiteFromConfig(
 		return nil, err
 	}
 
+	if site.Maintenance != nil {
+		if err := site.Maintenance.init(); err != nil {
+			return nil, fmt.Errorf("maintenance: %w", err)
+		}
+	}
+
+	if site.BatteryProtection != nil {
+		if err := site.BatteryProtection.init(); err != nil {
+			return nil, fmt.Errorf("battery protection: %w", err)
+		}
+	}
+
+	if site.BatteryBoost != nil {
+		if err := site.BatteryBoost.init(); err != nil {
+			return nil, fmt.Errorf("battery boost: %w", err)
+		}
+	}
+
+	if site.GridProtection != nil {
+		if err := site.GridProtection.init(); err != nil {
+			return nil, fmt.Errorf("grid protection: %w", err)
+		}
+	}
+
+	if site.MaxGridPower < 0 {
+		return nil, fmt.Errorf("invalid max grid power: %.0fW", site.MaxGridPower)
+	}
+
+	if site.DemandLimit < 0 {
+		return nil, fmt.Errorf("invalid demand limit: %.0fW", site.DemandLimit)
+	}
+
+	if site.GridFrequency != 50 && site.GridFrequency != 60 {
+		return nil, fmt.Errorf("invalid grid frequency: %.0fHz", site.GridFrequency)
+	}
+
 	Voltage = site.Voltage
 	site.loadpoints = loadpoints
 	site.tariffs = tariffs
@@ -127,7 +194,12 @@ func NewSiteFromConfig(
 	site.coordinator = coordinator.New(log, config.Instances(handler.Devices()))
 	handler.Subscribe(site.updateVehicles)
 
-	site.prioritizer = prioritizer.New(log)
+	sharingMode, err := prioritizer.ParseSharingMode(site.SharingMode)
+	if err != nil {
+		return nil, err
+	}
+
+	site.prioritizer = prioritizer.New(log, prioritizer.WithSharingMode(sharingMode))
 	site.stats = NewStats()
 
 	// upload telemetry on shutdown
@@ -141,8 +213,9 @@ func NewSiteFromConfig(
 
 	// give loadpoints access to vehicles and database
 	for _, lp := range loadpoints {
+		lp.DryRun = lp.DryRun || site.DryRun
 		lp.coordinator = coordinator.NewAdapter(lp, site.coordinator)
-		lp.planner = planner.New(lp.log, tariff)
+		lp.planner = planner.New(lp.log, tariff, planner.WithCheapWindows(lp.PreferCheapWindows), planner.WithExclusion(site.Maintenance.Active))
 
 		if db.Instance != nil {
 			var err error
@@ -202,6 +275,20 @@ func NewSiteFromConfig(
 		site.auxMeters = append(site.auxMeters, dev.Instance())
 	}
 
+	// ambient temperature
+	if site.TemperatureSensor != "" {
+		dev, err := config.Meters().ByName(site.TemperatureSensor)
+		if err != nil {
+			return nil, err
+		}
+
+		ts, ok := dev.Instance().(api.Temperature)
+		if !ok {
+			return nil, fmt.Errorf("temperature sensor %s does not provide temperature", site.TemperatureSensor)
+		}
+		site.temperatureSensor = ts
+	}
+
 	// configure meter from references
 	if site.gridMeter == nil && len(site.pvMeters) == 0 {
 		return nil, errors.New("missing either grid or pv meter")
@@ -222,9 +309,10 @@ func NewSiteFromConfig(
 // NewSite creates a Site with sane defaults
 func NewSite() *Site {
 	lp := &Site{
-		log:          util.NewLogger("site"),
-		publishCache: make(map[string]any),
-		Voltage:      230, // V
+		log:           util.NewLogger("site"),
+		publishCache:  make(map[string]any),
+		Voltage:       230, // V
+		GridFrequency: 50,  // Hz
 	}
 
 	return lp
@@ -573,6 +661,17 @@ func (site *Site) updateGridMeter() error {
 		}
 	}
 
+	// grid frequency
+	if frequencyMeter, ok := site.gridMeter.(api.Frequency); ok {
+		if f, err := frequencyMeter.Frequency(); err == nil {
+			site.log.DEBUG.Printf("grid frequency: %.2fHz", f)
+			site.publish(keys.GridFrequency, f)
+			site.updateGridFrequency(f)
+		} else {
+			site.log.ERROR.Printf("grid frequency: %v", err)
+		}
+	}
+
 	// grid energy (import)
 	if energyMeter, ok := site.gridMeter.(api.MeterEnergy); ok {
 		if f, err := energyMeter.TotalEnergy(); err == nil {
@@ -595,13 +694,24 @@ func (site *Site) updateMeters() error {
 	return site.updateGridMeter()
 }
 
+// refreshMeters updates the site meters, the values are used until the next refresh
+func (site *Site) refreshMeters() {
+	site.metersErr = site.updateMeters()
+}
+
 // sitePower returns
 //   - the net power exported by the site minus a residual margin
 //     (negative values mean grid: export, battery: charging
 //   - if battery buffer can be used for charging
-func (site *Site) sitePower(totalChargePower, flexiblePower float64) (float64, bool, bool, error) {
-	if err := site.updateMeters(); err != nil {
-		return 0, false, false, err
+//
+// Site meters are only read on refresh, otherwise the last values are used.
+func (site *Site) sitePower(totalChargePower, flexiblePower float64, refresh bool) (float64, bool, bool, error) {
+	if refresh {
+		site.refreshMeters()
+	}
+
+	if site.metersErr != nil {
+		return 0, false, false, site.metersErr
 	}
 
 	// allow using PV as estimate for grid power
@@ -623,9 +733,12 @@ func (site *Site) sitePower(totalChargePower, flexiblePower float64) (float64, b
 	batteryPower := site.batteryPower
 
 	// handed to loadpoint
-	var batteryBuffered, batteryStart bool
+	var batteryBuffered, batteryStart, batteryProtected bool
 
 	if len(site.batteryMeters) > 0 {
+		// updated before acquiring the read lock as it modifies the site state
+		batteryProtected = site.updateBatteryProtection()
+
 		site.RLock()
 		defer site.RUnlock()
 
@@ -633,7 +746,7 @@ func (site *Site) sitePower(totalChargePower, flexiblePower float64) (float64, b
 		if site.batterySoc < site.prioritySoc && batteryPower < 0 {
 			site.log.DEBUG.Printf("battery has priority at soc %.0f%% (< %.0f%%)", site.batterySoc, site.prioritySoc)
 			batteryPower = 0
-		} else {
+		} else if !batteryProtected {
 			// if battery is above bufferSoc allow using it for charging
 			batteryBuffered = site.bufferSoc > 0 && site.batterySoc > site.bufferSoc
 			batteryStart = site.bufferStartSoc > 0 && site.batterySoc > site.bufferStartSoc
@@ -642,6 +755,12 @@ func (site *Site) sitePower(totalChargePower, flexiblePower float64) (float64, b
 
 	sitePower := sitePower(site.log, site.MaxGridSupplyWhileBatteryCharging, site.gridPower, batteryPower, site.ResidualPower)
 
+	// no surplus while protected battery is discharging
+	if batteryProtected && batteryPower > 0 && sitePower < 0 {
+		site.log.DEBUG.Printf("battery protected at soc %.0f%% (< %.0f%%)", site.batterySoc, site.BatteryProtection.MinSoc)
+		sitePower = 0
+	}
+
 	// deduct smart loads
 	if len(site.auxMeters) > 0 {
 		var auxPower float64
@@ -746,16 +865,56 @@ func (site *Site) publishTariffs(greenShareHome float64, greenShareLoadpoints fl
 	}
 }
 
-func (site *Site) update(lp updater) {
+// update runs the control loop for the given loadpoint. On refresh, the site meters and all loadpoints' charge power
+// are updated. Otherwise, only the given loadpoint is updated and the last site meter values are used.
+func (site *Site) update(lp updater, refresh bool) {
 	site.log.DEBUG.Println("----")
 
+	// stop charging on grid protection relay fault, polled before the chargers
+	gridProtection := site.updateGridProtection()
+
+	// stop charging during scheduled maintenance
+	maintenance := site.Maintenance.Active(time.Now())
+	site.publish(keys.Maintenance, maintenance)
+
+	// derate charge current at high ambient temperature
+	site.updateTemperature()
+
+	rate, rateErr := site.plannerRate()
+	gridPrice, gridPriceErr := site.tariffs.CurrentGridPrice()
+
+	// pause charging on grid instability
+	frequencyPause := site.FrequencyControl && site.frequencyDeviation
+
+	for _, l := range site.loadpoints {
+		// stop other loadpoints immediately on grid protection fault
+		if l.setGridProtection(gridProtection) && l != lp {
+			l.requestUpdate()
+		}
+
+		// stop or resume other loadpoints immediately on price spikes
+		if l.setTariffCeiling(gridPrice, gridPriceErr == nil) && l != lp {
+			l.requestUpdate()
+		}
+
+		// update loadpoints immediately if tariff zone limit changes
+		if l.setTariffZone(tariffZone(site.smartCostActive(l, rate))) && l != lp {
+			l.requestUpdate()
+		}
+	}
+
 	// update all loadpoint's charge power
 	var totalChargePower float64
-	for _, lp := range site.loadpoints {
-		lp.UpdateChargePower()
-		totalChargePower += lp.GetChargePower()
+	for _, l := range site.loadpoints {
+		l.setMaintenance(maintenance)
+		l.setFrequencyPause(frequencyPause)
+		l.setTemperatureDerating(site.temperatureDerating)
+		if refresh || l == lp {
+			l.UpdateChargePower()
+		}
+		totalChargePower += l.GetChargePower()
 
-		site.prioritizer.UpdateChargePowerFlexibility(lp)
+		site.prioritizer.UpdateChargePowerFlexibility(l)
 	}
 
 	// prioritize if possible
@@ -765,13 +924,13 @@ func (site *Site) update(lp updater) {
 	}
 
 	var smartCostActive bool
-	if rate, err := site.plannerRate(); err == nil {
+	if rateErr == nil {
 		smartCostActive = site.smartCostActive(lp, rate)
 	} else {
-		site.log.WARN.Println("smartCost:", err)
+		site.log.WARN.Println("smartCost:", rateErr)
 	}
 
-	if sitePower, batteryBuffered, batteryStart, err := site.sitePower(totalChargePower, flexiblePower); err == nil {
+	if sitePower, batteryBuffered, batteryStart, err := site.sitePower(totalChargePower, flexiblePower, refresh); err == nil {
 		// ignore negative pvPower values as that means it is not an energy source but consumption
 		homePower := site.gridPower + max(0, site.pvPower) + site.batteryPower - totalChargePower
 		homePower = max(homePower, 0)
@@ -783,6 +942,13 @@ func (site *Site) update(lp updater) {
 		greenShareHome := site.greenShare(0, homePower)
 		greenShareLoadpoints := site.greenShare(nonChargePower, nonChargePower+totalChargePower)
 
+		if site.gridMeter != nil {
+			site.updateDemand()
+		}
+
+		// allocate grid capacity by loadpoint priority
+		site.updateGridAllocation(lp, totalChargePower)
+
 		lp.Update(sitePower, smartCostActive, batteryBuffered, batteryStart, greenShareLoadpoints, site.effectivePrice(greenShareLoadpoints), site.effectiveCo2(greenShareLoadpoints))
 
 		site.Health.Update()
@@ -796,11 +962,13 @@ func (site *Site) update(lp updater) {
 		site.log.ERROR.Println(err)
 	}
 
-	if site.batteryDischargeControl {
+	if site.batteryDischargeControl || site.BatteryBoost != nil {
 		site.updateBatteryMode()
 	}
 
 	site.stats.Update(site)
+
+	site.exportMetrics(lp)
 }
 
 // prepare publishes initial values
@@ -820,6 +988,7 @@ func (site *Site) prepare() {
 	site.publish(keys.BatteryMode, site.batteryMode)
 	site.publish(keys.BatteryDischargeControl, site.batteryDischargeControl)
 	site.publish(keys.ResidualPower, site.ResidualPower)
+	site.publish(keys.DryRun, site.DryRun)
 
 	site.publish(keys.Currency, site.tariffs.Currency)
 	if tariff := site.GetTariff(PlannerTariff); tariff != nil {
@@ -878,9 +1047,15 @@ func (site *Site) Prepare(uiChan chan<- util.Param, pushChan chan<- push.Event)
 
 // loopLoadpoints keeps iterating across loadpoints sending the next to the given channel
 func (site *Site) loopLoadpoints(next chan<- updater) {
+	if !site.hasRoundRobinLoadpoints() {
+		return
+	}
+
 	for {
 		for _, lp := range site.loadpoints {
-			next <- lp
+			if lp.PollInterval <= 0 {
+				next <- lp
+			}
 		}
 	}
 }
@@ -897,16 +1072,31 @@ func (site *Site) Run(stopC chan struct{}, interval time.Duration) {
 	loadpointChan := make(chan updater)
 	go site.loopLoadpoints(loadpointChan)
 
+	pollChan := make(chan updater)
+	site.pollLoadpoints(pollChan, stopC)
+
+	// site meters are read at the site interval, loadpoints without own poll interval are updated round-robin
+	tick := func() {
+		if site.hasRoundRobinLoadpoints() {
+			site.update(<-loadpointChan, true)
+		} else {
+			site.refreshMeters()
+		}
+	}
+
 	ticker := time.NewTicker(interval)
+	tick() // start immediately
+
 	site.publish(keys.Interval, interval.Seconds())
-	site.update(<-loadpointChan) // start immediately
 
 	for {
 		select {
 		case <-ticker.C:
-			site.update(<-loadpointChan)
+			tick()
+		case lp := <-pollChan:
+			site.update(lp, false)
 		case lp := <-site.lpUpdateChan:
-			site.update(lp)
+			site.update(lp, true)
 		case <-stopC:
 			return
 		}
@@ -54,4 +54,11 @@ type API interface {
 
 	GetBatteryDischargeControl() bool
 	SetBatteryDischargeControl(bool) error
+
+	//
+	// grid protection
+	//
+
+	// ResetGridProtection confirms resuming charging after a grid protection relay fault
+	ResetGridProtection() error
 }
@@ -319,3 +319,31 @@ func (site *Site) SetBatteryDischargeControl(val bool) error {
 
 	return nil
 }
+
+// ResetGridProtection confirms resuming charging after a grid protection relay fault
+func (site *Site) ResetGridProtection() error {
+	if site.GridProtection == nil {
+		return errors.New("grid protection not configured")
+	}
+
+	fault, err := site.GridProtection.Fault()
+	if err != nil {
+		return err
+	}
+
+	if fault {
+		return errors.New("grid protection relay still reports fault")
+	}
+
+	site.Lock()
+	defer site.Unlock()
+
+	if site.gridProtectionFault {
+		site.log.INFO.Println("grid protection: fault confirmed, charging resumed")
+		site.gridProtectionFault = false
+	}
+
+	site.publish(keys.GridProtectionFault, false)
+
+	return nil
+}
@@ -75,19 +75,26 @@ func (site *Site) smartCostActive(lp loadpoint.API, rate *api.Rate) bool {
 func (site *Site) updateBatteryMode() {
 	mode := api.BatteryNormal
 
-	rate, err := site.plannerRate()
-	if err != nil {
-		site.log.WARN.Println("smart cost:", err)
-	}
+	if site.batteryDischargeControl {
+		rate, err := site.plannerRate()
+		if err != nil {
+			site.log.WARN.Println("smart cost:", err)
+		}
 
-	for _, lp := range site.Loadpoints() {
-		smartCostActive := site.smartCostActive(lp, rate)
-		if lp.GetStatus() == api.StatusC && (smartCostActive || lp.IsFastChargingActive()) {
-			mode = api.BatteryHold
-			break
+		for _, lp := range site.Loadpoints() {
+			smartCostActive := site.smartCostActive(lp, rate)
+			if lp.GetStatus() == api.StatusC && (smartCostActive || lp.IsFastChargingActive()) {
+				mode = api.BatteryHold
+				break
+			}
 		}
 	}
 
+	// discharge full battery into pv charging vehicles
+	if site.batteryBoostActive() && mode == api.BatteryNormal {
+		mode = api.BatteryDischarge
+	}
+
 	if batMode := site.GetBatteryMode(); mode != batMode {
 		if err := site.applyBatteryMode(mode); err != nil {
 			site.log.ERROR.Println("battery mode:", err)
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// BatteryBoostConfig contains the home battery soc above which the battery is discharged into pv charging vehicles
+type BatteryBoostConfig struct {
+	Soc        float64 // discharge battery while exporting at or above this soc
+	Hysteresis float64 // stop discharging below soc - hysteresis
+
+	active bool
+}
+
+// init validates the battery boost config
+func (p *BatteryBoostConfig) init() error {
+	if p.Soc <= 0 || p.Soc > 100 {
+		return fmt.Errorf("invalid soc: %.0f%%", p.Soc)
+	}
+
+	if p.Hysteresis < 0 || p.Hysteresis > p.Soc {
+		return fmt.Errorf("invalid hysteresis: %.0f%%", p.Hysteresis)
+	}
+
+	if p.Hysteresis == 0 {
+		p.Hysteresis = 5
+	}
+
+	return nil
+}
+
+// Active updates and returns if the battery should be discharged at the given soc. Discharging starts while
+// exporting surplus and continues until soc drops below soc - hysteresis or no vehicle is pv charging anymore.
+func (p *BatteryBoostConfig) Active(soc float64, surplus, charging bool) bool {
+	if p == nil {
+		return false
+	}
+
+	switch {
+	case !charging || soc < p.Soc-p.Hysteresis:
+		p.active = false
+	case surplus && soc >= p.Soc:
+		p.active = true
+	}
+
+	return p.active
+}
+
+// batteryBoostActive updates and returns if the battery should be discharged for pv charging
+func (site *Site) batteryBoostActive() bool {
+	var charging bool
+	for _, lp := range site.Loadpoints() {
+		if lp.GetStatus() == api.StatusC && lp.GetMode() == api.ModePV {
+			charging = true
+			break
+		}
+	}
+
+	return site.BatteryBoost.Active(site.batterySoc, site.gridPower < 0, charging)
+}
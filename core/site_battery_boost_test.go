@@ -0,0 +1,38 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatteryBoost(t *testing.T) {
+	p := &BatteryBoostConfig{Soc: 95}
+	require.NoError(t, p.init())
+	assert.Equal(t, 5.0, p.Hysteresis)
+
+	for _, tc := range []struct {
+		soc               float64
+		surplus, charging bool
+		active            bool
+	}{
+		{100, true, false, false}, // not charging
+		{90, true, true, false},
+		{100, false, true, false}, // no surplus
+		{95, true, true, true},
+		{92, false, true, true}, // hysteresis
+		{89, false, true, false},
+		{96, true, true, true},
+		{96, true, false, false}, // charging stopped
+	} {
+		assert.Equal(t, tc.active, p.Active(tc.soc, tc.surplus, tc.charging), tc)
+	}
+
+	var nilConfig *BatteryBoostConfig
+	assert.False(t, nilConfig.Active(100, true, true))
+
+	require.Error(t, (&BatteryBoostConfig{}).init())
+	require.Error(t, (&BatteryBoostConfig{Soc: 101}).init())
+	require.Error(t, (&BatteryBoostConfig{Soc: 95, Hysteresis: -1}).init())
+}
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/evcc-io/evcc/core/keys"
+)
+
+// BatteryProtectionConfig contains the minimum home battery soc that must not be used for charging
+type BatteryProtectionConfig struct {
+	MinSoc     float64 // protect battery below this soc
+	Hysteresis float64 // release protection above minSoc + hysteresis
+
+	active bool
+}
+
+// init validates the battery protection config
+func (p *BatteryProtectionConfig) init() error {
+	if p.MinSoc <= 0 || p.MinSoc >= 100 {
+		return fmt.Errorf("invalid min soc: %.0f%%", p.MinSoc)
+	}
+
+	if p.Hysteresis < 0 || p.MinSoc+p.Hysteresis > 100 {
+		return fmt.Errorf("invalid hysteresis: %.0f%%", p.Hysteresis)
+	}
+
+	if p.Hysteresis == 0 {
+		p.Hysteresis = 5
+	}
+
+	return nil
+}
+
+// Active updates and returns if the battery is protected at the given soc
+func (p *BatteryProtectionConfig) Active(soc float64) bool {
+	if p == nil {
+		return false
+	}
+
+	switch {
+	case soc < p.MinSoc:
+		p.active = true
+	case soc >= p.MinSoc+p.Hysteresis:
+		p.active = false
+	}
+
+	return p.active
+}
+
+// updateBatteryProtection updates and returns if the battery is protected from discharging for charging
+func (site *Site) updateBatteryProtection() bool {
+	site.Lock()
+	soc := site.batterySoc
+	active := site.BatteryProtection.Active(soc)
+	changed := active != site.batteryProtected
+	site.batteryProtected = active
+	site.Unlock()
+
+	if changed {
+		if active {
+			site.log.INFO.Printf("battery protection: soc %.0f%% below %.0f%%", soc, site.BatteryProtection.MinSoc)
+		} else {
+			site.log.INFO.Printf("battery protection: soc %.0f%% recovered", soc)
+		}
+
+		site.publish(keys.BatteryProtection, active)
+	}
+
+	return active
+}
@@ -0,0 +1,34 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatteryProtection(t *testing.T) {
+	p := &BatteryProtectionConfig{MinSoc: 20}
+	require.NoError(t, p.init())
+	assert.Equal(t, 5.0, p.Hysteresis)
+
+	for _, tc := range []struct {
+		soc    float64
+		active bool
+	}{
+		{50, false},
+		{20, false},
+		{19, true},
+		{22, true}, // hysteresis
+		{25, false},
+		{22, false},
+	} {
+		assert.Equal(t, tc.active, p.Active(tc.soc), tc.soc)
+	}
+
+	var nilConfig *BatteryProtectionConfig
+	assert.False(t, nilConfig.Active(0))
+
+	require.Error(t, (&BatteryProtectionConfig{}).init())
+	require.Error(t, (&BatteryProtectionConfig{MinSoc: 20, Hysteresis: -1}).init())
+}
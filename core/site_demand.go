@@ -0,0 +1,111 @@
+package core
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/core/keys"
+)
+
+// demandInterval is the billing interval of the grid demand
+const demandInterval = 15 * time.Minute
+
+// demandWindow tracks the average grid import of the current demand interval
+type demandWindow struct {
+	start   time.Time // window start
+	updated time.Time // last sample
+	power   float64   // last sample's grid import
+	energy  float64   // Ws imported since window start until last sample
+}
+
+// update adds a grid power sample. If a new window was started, the average demand of the previous window is returned.
+func (w *demandWindow) update(ts time.Time, power float64) (float64, bool) {
+	power = max(0, power)
+	start := ts.Truncate(demandInterval)
+
+	if w.start.IsZero() {
+		w.start, w.updated, w.power = start, ts, power
+		return 0, false
+	}
+
+	var (
+		prev      float64
+		completed bool
+	)
+
+	if start.After(w.start) {
+		// previous sample's power lasts until the end of its window
+		end := w.start.Add(demandInterval)
+		prev = (w.energy + w.power*end.Sub(w.updated).Seconds()) / demandInterval.Seconds()
+		completed = true
+
+		w.start, w.updated, w.energy = start, start, 0
+	}
+
+	w.energy += w.power * ts.Sub(w.updated).Seconds()
+	w.updated, w.power = ts, power
+
+	return prev, completed
+}
+
+// remaining returns the remaining window duration after the last sample
+func (w *demandWindow) remaining() time.Duration {
+	return w.start.Add(demandInterval).Sub(w.updated)
+}
+
+// progress returns the elapsed fraction of the current window
+func (w *demandWindow) progress() float64 {
+	return 1 - w.remaining().Seconds()/demandInterval.Seconds()
+}
+
+// average returns the average demand of the current window so far
+func (w *demandWindow) average() float64 {
+	if elapsed := w.updated.Sub(w.start).Seconds(); elapsed > 0 {
+		return w.energy / elapsed
+	}
+	return w.power
+}
+
+// projected returns the average demand at the end of the current window if the last sample's power continues
+func (w *demandWindow) projected() float64 {
+	return (w.energy + w.power*w.remaining().Seconds()) / demandInterval.Seconds()
+}
+
+// allowance returns the grid power that can be imported for the remainder of the window without exceeding the limit
+func (w *demandWindow) allowance(limit float64) float64 {
+	remaining := w.remaining().Seconds()
+	if remaining <= 0 {
+		return limit
+	}
+	return max(0, (limit*demandInterval.Seconds()-w.energy)/remaining)
+}
+
+// updateDemand updates the demand window with the current grid power
+func (site *Site) updateDemand() {
+	if prev, ok := site.demand.update(time.Now(), site.gridPower); ok {
+		site.log.DEBUG.Printf("demand: %.0fW average in previous window", prev)
+		site.publish(keys.DemandPrevious, prev)
+	}
+
+	projected := site.demand.projected()
+	if site.DemandLimit > 0 && projected > site.DemandLimit {
+		site.log.DEBUG.Printf("demand: projected %.0fW exceeds limit %.0fW", projected, site.DemandLimit)
+	}
+
+	site.publish(keys.DemandAverage, site.demand.average())
+	site.publish(keys.DemandProjected, projected)
+	site.publish(keys.DemandWindowProgress, site.demand.progress())
+}
+
+// gridLimit returns the grid power available to all consumers or false if unlimited
+func (site *Site) gridLimit() (float64, bool) {
+	limit := site.MaxGridPower
+
+	if site.DemandLimit > 0 && site.gridMeter != nil {
+		if allowance := site.demand.allowance(site.DemandLimit); limit <= 0 || allowance < limit {
+			limit = allowance
+		}
+		return limit, true
+	}
+
+	return limit, limit > 0
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDemandWindow(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var w demandWindow
+
+	_, ok := w.update(start, 20000)
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, w.progress())
+	assert.Equal(t, 20000.0, w.projected())
+	assert.Equal(t, 25000.0, w.allowance(25000))
+
+	// export is no demand
+	_, ok = w.update(start.Add(5*time.Minute), -5000)
+	assert.False(t, ok)
+	assert.InDelta(t, 1.0/3, w.progress(), 1e-6)
+	assert.Equal(t, 20000.0, w.average())
+	assert.InDelta(t, 20000.0/3, w.projected(), 1e-6)
+
+	// 25kW limit allows 27.5kW for the remaining 10 minutes
+	assert.InDelta(t, 27500, w.allowance(25000), 1e-6)
+
+	_, ok = w.update(start.Add(10*time.Minute), 30000)
+	assert.False(t, ok)
+	assert.InDelta(t, 10000, w.average(), 1e-6)
+	assert.InDelta(t, 16666.67, w.projected(), 1)
+
+	// next window completes the previous one
+	prev, ok := w.update(start.Add(16*time.Minute), 10000)
+	assert.True(t, ok)
+	assert.InDelta(t, 16666.67, prev, 1)
+	assert.InDelta(t, 30000, w.average(), 1e-6)
+	assert.InDelta(t, 1.0/15, w.progress(), 1e-6)
+}
@@ -0,0 +1,48 @@
+package core
+
+// Exporter receives device metrics
+type Exporter interface {
+	Export(name string, dev any) error
+}
+
+// SetExporter sets the exporter receiving device metrics after each update
+func (site *Site) SetExporter(exporter Exporter) {
+	site.exporter = exporter
+}
+
+// exportMetrics exports the site meters and the updated loadpoint's devices
+func (site *Site) exportMetrics(lp updater) {
+	if site.exporter == nil {
+		return
+	}
+
+	type device struct {
+		name string
+		dev  any
+	}
+
+	var devs []device
+
+	if site.gridMeter != nil {
+		devs = append(devs, device{site.Meters.GridMeterRef, site.gridMeter})
+	}
+	for i, ref := range site.Meters.PVMetersRef {
+		devs = append(devs, device{ref, site.pvMeters[i]})
+	}
+	for i, ref := range site.Meters.BatteryMetersRef {
+		devs = append(devs, device{ref, site.batteryMeters[i]})
+	}
+
+	if lp, ok := lp.(*Loadpoint); ok {
+		devs = append(devs, device{lp.ChargerRef, lp.charger})
+		if lp.MeterRef != "" {
+			devs = append(devs, device{lp.MeterRef, lp.chargeMeter})
+		}
+	}
+
+	for _, d := range devs {
+		if err := site.exporter.Export(d.name, d.dev); err != nil {
+			site.log.ERROR.Printf("export: %v", err)
+		}
+	}
+}
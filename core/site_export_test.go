@@ -0,0 +1,45 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type exporter struct {
+	names []string
+}
+
+func (e *exporter) Export(name string, dev any) error {
+	e.names = append(e.names, name)
+	return nil
+}
+
+func TestExportMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp := &Loadpoint{
+		ChargerRef: "wallbox",
+		charger:    api.NewMockCharger(ctrl),
+	}
+
+	site := &Site{
+		log:        util.NewLogger("foo"),
+		Meters:     MetersConfig{GridMeterRef: "grid", PVMetersRef: []string{"pv"}},
+		gridMeter:  api.NewMockMeter(ctrl),
+		pvMeters:   []api.Meter{api.NewMockMeter(ctrl)},
+		loadpoints: []*Loadpoint{lp},
+	}
+
+	// no exporter configured
+	site.exportMetrics(lp)
+
+	e := new(exporter)
+	site.SetExporter(e)
+	site.exportMetrics(lp)
+
+	assert.Equal(t, []string{"grid", "pv", "wallbox"}, e.names)
+}
@@ -0,0 +1,19 @@
+package core
+
+import "math"
+
+// maxFrequencyDeviation is the allowed deviation from nominal grid frequency
+const maxFrequencyDeviation = 0.5 // Hz
+
+// updateGridFrequency checks the grid frequency against the nominal frequency
+func (site *Site) updateGridFrequency(f float64) {
+	deviation := math.Abs(f-site.GridFrequency) > maxFrequencyDeviation
+
+	if deviation {
+		site.log.WARN.Printf("grid frequency %.2fHz deviates from nominal %.0fHz", f, site.GridFrequency)
+	} else if site.frequencyDeviation {
+		site.log.INFO.Printf("grid frequency %.2fHz back within range", f)
+	}
+
+	site.frequencyDeviation = deviation
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"slices"
+)
+
+// allocationDemand is a loadpoint's demand for grid capacity
+type allocationDemand struct {
+	priority           int
+	minPower, maxPower float64
+}
+
+// allocateGridPower allocates the available power to the demands by priority.
+// Higher priority demands receive their min power first. Demands whose min power cannot be covered receive 0 and
+// are stopped, lower priority demands are still served from the power left over. The remaining power is distributed to the higher priority demands first
+// up to their max power, proportionally to their remaining demand within the same priority.
+func allocateGridPower(available float64, demands []allocationDemand) []float64 {
+	res := make([]float64, len(demands))
+
+	order := make([]int, len(demands))
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortStableFunc(order, func(a, b int) int {
+		return demands[b].priority - demands[a].priority
+	})
+
+	// min power by priority
+	for _, i := range order {
+		if demands[i].minPower > available {
+			continue
+		}
+
+		res[i] = demands[i].minPower
+		available -= demands[i].minPower
+	}
+
+	// remaining power by priority, proportionally within the same priority
+	for start := 0; start < len(order) && available > 0; {
+		end := start
+		for end < len(order) && demands[order[end]].priority == demands[order[start]].priority {
+			end++
+		}
+
+		var headroom float64
+		for _, i := range order[start:end] {
+			if res[i] > 0 {
+				headroom += demands[i].maxPower - res[i]
+			}
+		}
+
+		share := 1.0
+		if headroom > available {
+			share = available / headroom
+		}
+
+		for _, i := range order[start:end] {
+			if res[i] > 0 {
+				add := share * (demands[i].maxPower - res[i])
+				res[i] += add
+				available -= add
+			}
+		}
+
+		start = end
+	}
+
+	return res
+}
+
+// updateGridAllocation allocates the grid capacity available for charging to the loadpoints by priority.
+// The grid capacity is limited by max grid power and the demand limit.
+func (site *Site) updateGridAllocation(lp updater, totalChargePower float64) {
+	limit, ok := site.gridLimit()
+	if !ok {
+		return
+	}
+
+	// grid capacity not used by other consumers
+	available := max(0, limit-site.gridPower+totalChargePower)
+
+	var (
+		lps     []*Loadpoint
+		demands []allocationDemand
+	)
+
+	for _, l := range site.loadpoints {
+		if d, ok := l.gridDemand(); ok {
+			lps = append(lps, l)
+			demands = append(demands, d)
+		} else {
+			l.setGridAllocation(0, false)
+		}
+	}
+
+	for i, power := range allocateGridPower(available, demands) {
+		l := lps[i]
+
+		if power == 0 && !l.gridAllocationStop() {
+			site.log.DEBUG.Printf("grid allocation: insufficient capacity for lp %s at prio %d", l.Title(), demands[i].priority)
+		}
+
+		l.setGridAllocation(power, true)
+
+		// reduce other loadpoints immediately if exceeding their allocation
+		if l != lp && l.GetChargePower() > power {
+			l.requestUpdate()
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocateGridPower(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		available float64
+		demands   []allocationDemand
+		res       []float64
+	}{
+		{"sufficient", 30000, []allocationDemand{
+			{0, 4140, 11040},
+			{1, 4140, 11040},
+		}, []float64{11040, 11040}},
+		{"higher priority first", 16000, []allocationDemand{
+			{0, 4140, 11040},
+			{1, 4140, 11040},
+		}, []float64{4960, 11040}},
+		{"lower priority stopped", 6000, []allocationDemand{
+			{0, 4140, 11040},
+			{1, 4140, 11040},
+		}, []float64{0, 6000}},
+		{"proportional within priority", 10000, []allocationDemand{
+			{1, 1380, 3680},
+			{1, 4140, 11040},
+			{0, 1380, 3680},
+		}, []float64{2155, 6465, 1380}},
+		{"higher priority not covered", 4000, []allocationDemand{
+			{1, 4140, 11040},
+			{0, 1380, 3680},
+		}, []float64{0, 3680}},
+	} {
+		res := allocateGridPower(tc.available, tc.demands)
+		assert.InDeltaSlice(t, tc.res, res, 1, tc.name)
+	}
+}
+
+func TestGridDemandActive(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		status  api.ChargeStatus
+		mode    api.ChargeMode
+		enabled bool
+		pvTimer bool
+		res     bool
+	}{
+		{"charging", api.StatusC, api.ModePV, true, false, true},
+		{"pv idle", api.StatusB, api.ModePV, false, false, false},
+		{"pv enable timer", api.StatusB, api.ModePV, false, true, true},
+		{"pv enabled", api.StatusB, api.ModePV, true, false, true},
+		{"now", api.StatusB, api.ModeNow, false, false, true},
+		{"lazy idle", api.StatusB, api.ModeLazy, false, false, false},
+	} {
+		lp := &Loadpoint{
+			status:  tc.status,
+			mode:    tc.mode,
+			enabled: tc.enabled,
+		}
+		if tc.pvTimer {
+			lp.pvTimer = time.Now()
+		}
+
+		assert.Equal(t, tc.res, lp.gridDemandActive(), tc.name)
+	}
+}
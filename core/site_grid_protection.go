@@ -0,0 +1,67 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/provider"
+)
+
+// GridProtectionConfig contains the grid protection relay status and the value signalling a fault
+type GridProtectionConfig struct {
+	Status     provider.Config // relay status
+	FaultValue int64           `mapstructure:"faultValue"` // status value signalling a fault
+
+	statusG func() (int64, error)
+}
+
+// init validates the grid protection config
+func (p *GridProtectionConfig) init() error {
+	if p.Status.Source == "" {
+		return errors.New("missing status")
+	}
+
+	statusG, err := provider.NewIntGetterFromConfig(p.Status)
+	if err != nil {
+		return err
+	}
+
+	p.statusG = statusG
+
+	return nil
+}
+
+// Fault returns if the relay reports a fault. Status read errors are treated as fault.
+func (p *GridProtectionConfig) Fault() (bool, error) {
+	status, err := p.statusG()
+	if err != nil {
+		return true, err
+	}
+
+	return status == p.FaultValue, nil
+}
+
+// updateGridProtection polls the grid protection relay and returns if charging is stopped.
+// A fault is latched until confirmed by the user using ResetGridProtection.
+func (site *Site) updateGridProtection() bool {
+	if site.GridProtection == nil {
+		return false
+	}
+
+	fault, err := site.GridProtection.Fault()
+	if err != nil {
+		site.log.ERROR.Printf("grid protection: %v", err)
+	}
+
+	site.Lock()
+	defer site.Unlock()
+
+	if fault && !site.gridProtectionFault {
+		site.log.WARN.Println("grid protection: relay fault, charging stopped until confirmed")
+		site.gridProtectionFault = true
+	}
+
+	site.publish(keys.GridProtectionFault, site.gridProtectionFault)
+
+	return site.gridProtectionFault
+}
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGridProtectionLatched(t *testing.T) {
+	var (
+		status int64
+		err    error
+	)
+
+	site := &Site{
+		log: util.NewLogger("foo"),
+		GridProtection: &GridProtectionConfig{
+			FaultValue: 1,
+			statusG: func() (int64, error) {
+				return status, err
+			},
+		},
+	}
+
+	assert.False(t, site.updateGridProtection())
+
+	// fault stops charging
+	status = 1
+	assert.True(t, site.updateGridProtection())
+	require.Error(t, site.ResetGridProtection())
+
+	// cleared fault requires confirmation
+	status = 0
+	assert.True(t, site.updateGridProtection())
+	require.NoError(t, site.ResetGridProtection())
+	assert.False(t, site.updateGridProtection())
+
+	// read errors are treated as fault
+	err = errors.New("timeout")
+	assert.True(t, site.updateGridProtection())
+	require.Error(t, site.ResetGridProtection())
+}
+
+func TestGridProtectionNotConfigured(t *testing.T) {
+	site := &Site{log: util.NewLogger("foo")}
+
+	assert.False(t, site.updateGridProtection())
+	assert.Error(t, site.ResetGridProtection())
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/evcc-io/evcc/tariff/fixed"
+)
+
+// MaintenanceConfig contains the site's scheduled maintenance window
+type MaintenanceConfig struct {
+	Start, End string   // time of day, e.g. 22:00
+	Days       []string // days the window starts on, empty for every day
+
+	start, end int // minutes of day
+	days       []fixed.Day
+}
+
+// init validates and parses the maintenance window
+func (m *MaintenanceConfig) init() error {
+	for _, v := range []struct {
+		s   string
+		res *int
+	}{
+		{m.Start, &m.start},
+		{m.End, &m.end},
+	} {
+		t, err := time.Parse("15:04", v.s)
+		if err != nil {
+			return fmt.Errorf("invalid time: %s", v.s)
+		}
+		*v.res = 60*t.Hour() + t.Minute()
+	}
+
+	if m.start == m.end {
+		return fmt.Errorf("invalid maintenance window: %s-%s", m.Start, m.End)
+	}
+
+	for _, s := range m.Days {
+		d, err := fixed.ParseDay(s)
+		if err != nil {
+			return err
+		}
+		m.days = append(m.days, d)
+	}
+
+	return nil
+}
+
+// startsOn checks if the maintenance window starts on the day of t
+func (m *MaintenanceConfig) startsOn(t time.Time) bool {
+	return len(m.days) == 0 || slices.Contains(m.days, fixed.Day(t.Weekday()))
+}
+
+// Active checks if t is inside the maintenance window
+func (m *MaintenanceConfig) Active(t time.Time) bool {
+	if m == nil {
+		return false
+	}
+
+	minutes := 60*t.Hour() + t.Minute()
+
+	// window within a single day
+	if m.start < m.end {
+		return m.start <= minutes && minutes < m.end && m.startsOn(t)
+	}
+
+	// window across midnight
+	return minutes >= m.start && m.startsOn(t) ||
+		minutes < m.end && m.startsOn(t.AddDate(0, 0, -1))
+}
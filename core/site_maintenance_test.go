@@ -0,0 +1,45 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceActive(t *testing.T) {
+	m := &MaintenanceConfig{
+		Start: "22:00",
+		End:   "06:00",
+		Days:  []string{"saturday", "sunday"},
+	}
+	require.NoError(t, m.init())
+
+	// 2024-06-01 is a saturday
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2024, 6, day, hour, min, 0, 0, time.Local)
+	}
+
+	for _, tc := range []struct {
+		ts     time.Time
+		active bool
+	}{
+		{at(1, 21, 59), false},
+		{at(1, 22, 0), true},
+		{at(2, 5, 59), true}, // sunday morning
+		{at(2, 6, 0), false},
+		{at(2, 23, 0), true},  // sunday night
+		{at(3, 3, 0), true},   // monday morning
+		{at(3, 23, 0), false}, // monday night
+		{at(4, 3, 0), false},
+	} {
+		assert.Equal(t, tc.active, m.Active(tc.ts), tc.ts)
+	}
+
+	var nilConfig *MaintenanceConfig
+	assert.False(t, nilConfig.Active(at(1, 23, 0)))
+
+	require.Error(t, (&MaintenanceConfig{Start: "22:00", End: "22:00"}).init())
+	require.Error(t, (&MaintenanceConfig{Start: "22:00", End: "06:00", Days: []string{"foo"}}).init())
+}
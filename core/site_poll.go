@@ -0,0 +1,64 @@
+package core
+
+import (
+	"time"
+)
+
+// hasRoundRobinLoadpoints checks if any loadpoint is updated round-robin at the site interval
+func (site *Site) hasRoundRobinLoadpoints() bool {
+	for _, lp := range site.loadpoints {
+		if lp.PollInterval <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// pollOffset returns the loadpoint's start offset for spreading updates evenly across the poll interval
+func pollOffset(index, count int, interval time.Duration) time.Duration {
+	return time.Duration(index) * interval / time.Duration(count)
+}
+
+// pollLoadpoints sends loadpoints with own poll interval to the given channel until stopC is closed.
+// Start times are staggered such that chargers sharing a bus are not polled simultaneously.
+// Adaptive polling loadpoints are polled at their current interval, restarting on charger status change.
+// Polls only update the polled loadpoint, site meters are read at the site interval.
+func (site *Site) pollLoadpoints(next chan<- updater, stopC <-chan struct{}) {
+	for i, lp := range site.loadpoints {
+		if lp.PollInterval <= 0 {
+			continue
+		}
+
+		offset := pollOffset(i, len(site.loadpoints), lp.PollInterval)
+
+		go func() {
+			select {
+			case <-time.After(offset):
+			case <-stopC:
+				return
+			}
+
+			timer := time.NewTimer(0)
+			defer timer.Stop()
+
+			for {
+				select {
+				case <-timer.C:
+					select {
+					case next <- lp:
+					case <-stopC:
+						return
+					}
+				case <-lp.pollResetC:
+					if !timer.Stop() {
+						<-timer.C
+					}
+				case <-stopC:
+					return
+				}
+
+				timer.Reset(lp.nextPollInterval())
+			}
+		}()
+	}
+}
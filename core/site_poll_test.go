@@ -0,0 +1,76 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPollOffset(t *testing.T) {
+	assert.Equal(t, time.Duration(0), pollOffset(0, 4, 10*time.Second))
+	assert.Equal(t, 2500*time.Millisecond, pollOffset(1, 4, 10*time.Second))
+	assert.Equal(t, 7500*time.Millisecond, pollOffset(3, 4, 10*time.Second))
+}
+
+func TestRoundRobinLoadpoints(t *testing.T) {
+	site := &Site{
+		loadpoints: []*Loadpoint{{PollInterval: time.Second}},
+	}
+	assert.False(t, site.hasRoundRobinLoadpoints())
+
+	site.loadpoints = append(site.loadpoints, new(Loadpoint))
+	assert.True(t, site.hasRoundRobinLoadpoints())
+}
+
+func TestPollLoadpointsStop(t *testing.T) {
+	lp := &Loadpoint{PollInterval: 10 * time.Millisecond}
+	site := &Site{loadpoints: []*Loadpoint{lp}}
+
+	next := make(chan updater)
+	stopC := make(chan struct{})
+	site.pollLoadpoints(next, stopC)
+
+	assert.Equal(t, updater(lp), <-next)
+	close(stopC)
+
+	// pending send is abandoned after stop
+	time.Sleep(50 * time.Millisecond)
+	select {
+	case <-next:
+		t.Fatal("unexpected poll after stop")
+	default:
+	}
+}
+
+func TestSitePowerRefresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	grid := api.NewMockMeter(ctrl)
+	grid.EXPECT().CurrentPower().Return(1000.0, nil).Times(1)
+
+	site := &Site{
+		log:       util.NewLogger("foo"),
+		gridMeter: grid,
+	}
+
+	// site meters are read on refresh
+	power, _, _, err := site.sitePower(0, 0, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1000.0, power)
+
+	// loadpoint polls use the last values
+	power, _, _, err = site.sitePower(0, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1000.0, power)
+
+	// including the last error
+	site.metersErr = errors.New("foo")
+	_, _, _, err = site.sitePower(0, 0, false)
+	require.Error(t, err)
+}
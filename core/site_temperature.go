@@ -0,0 +1,61 @@
+package core
+
+import (
+	"github.com/evcc-io/evcc/core/keys"
+)
+
+// ambient temperature derating curve
+const (
+	deratingStartTemperature  = 35.0 // °C, no derating below
+	deratingFullTemperature   = 50.0 // °C, max derating above
+	deratingStopTemperature   = 55.0 // °C, charging stopped above
+	deratingResumeTemperature = 50.0 // °C, charging resumed below after stop
+	deratingMax               = 0.2  // max current reduction before stop
+)
+
+// temperatureDerating returns the max current reduction for the ambient temperature, 1 stops charging.
+// Once stopped, charging resumes only below the resume temperature.
+func temperatureDerating(t float64, stopped bool) float64 {
+	switch {
+	case t > deratingStopTemperature, stopped && t >= deratingResumeTemperature:
+		return 1
+	case t >= deratingFullTemperature:
+		return deratingMax
+	case t > deratingStartTemperature:
+		return deratingMax * (t - deratingStartTemperature) / (deratingFullTemperature - deratingStartTemperature)
+	default:
+		return 0
+	}
+}
+
+// updateTemperature reads the ambient temperature and updates the derating
+func (site *Site) updateTemperature() {
+	if site.temperatureSensor == nil {
+		return
+	}
+
+	t, err := site.temperatureSensor.Temperature()
+	if err != nil {
+		// keep previous derating
+		site.log.ERROR.Printf("temperature: %v", err)
+		return
+	}
+
+	site.log.DEBUG.Printf("temperature: %.1f°C", t)
+	site.publish(keys.Temperature, t)
+
+	derating := temperatureDerating(t, site.temperatureDerating == 1)
+
+	// log state transitions only
+	switch prev := site.temperatureDerating; {
+	case derating == prev:
+	case derating == 1:
+		site.log.WARN.Printf("temperature %.1f°C above %.0f°C: charging stopped", t, deratingStopTemperature)
+	case derating > 0 && (prev == 0 || prev == 1):
+		site.log.WARN.Printf("temperature %.1f°C: derating charge current by %.0f%%", t, 100*derating)
+	case derating == 0:
+		site.log.INFO.Printf("temperature %.1f°C: derating finished", t)
+	}
+
+	site.temperatureDerating = derating
+}
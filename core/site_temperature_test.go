@@ -0,0 +1,52 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTemperatureDerating(t *testing.T) {
+	for _, tc := range []struct {
+		temp     float64
+		stopped  bool
+		derating float64
+	}{
+		{20, false, 0},
+		{35, false, 0},
+		{42.5, false, 0.1},
+		{50, false, 0.2},
+		{55, false, 0.2},
+		{55.1, false, 1},
+		// resume hysteresis after stop
+		{52, true, 1},
+		{50, true, 1},
+		{49.9, true, 0.1987},
+		{42.5, true, 0.1},
+	} {
+		assert.InDelta(t, tc.derating, temperatureDerating(tc.temp, tc.stopped), 1e-4, tc)
+	}
+}
+
+func TestTemperatureDeratingMaxCurrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.charger = api.NewMockCharger(ctrl)
+	assert.Equal(t, 16.0, lp.effectiveMaxCurrent())
+
+	lp.setTemperatureDerating(temperatureDerating(50, false))
+	assert.InDelta(t, 12.8, lp.effectiveMaxCurrent(), 1e-9)
+
+	// not derated below min current
+	lp.maxCurrent = 6
+	assert.Equal(t, 6.0, lp.effectiveMaxCurrent())
+	lp.maxCurrent = 16
+
+	// stop is handled by the loadpoint update
+	lp.setTemperatureDerating(temperatureDerating(60, false))
+	assert.Equal(t, 16.0, lp.effectiveMaxCurrent())
+}
@@ -17,6 +17,7 @@ type Estimator struct {
 	charger  api.Charger
 	vehicle  api.Vehicle
 	estimate bool
+	failed   bool // last vehicle soc request failed
 
 	capacity          float64 // vehicle capacity in Wh cached to simplify testing
 	virtualCapacity   float64 // estimated virtual vehicle capacity in Wh
@@ -58,6 +59,11 @@ func (s *Estimator) Reset() {
 	s.maxChargeSoc = 50      // default 50%
 }
 
+// Unreachable returns true if the last vehicle soc request failed
+func (s *Estimator) Unreachable() bool {
+	return s.failed
+}
+
 // RemainingChargeDuration returns the estimated remaining duration
 func (s *Estimator) RemainingChargeDuration(targetSoc int, chargePower float64) time.Duration {
 	const minChargeSoc = 100
@@ -129,6 +135,8 @@ func (s *Estimator) Soc(chargedEnergy float64) (float64, error) {
 
 	if fetchedSoc == nil {
 		f, err := Guard(s.vehicle.Soc())
+		s.failed = err != nil && !errors.Is(err, api.ErrMustRetry)
+
 		if err != nil {
 			// required for online APIs with refreshkey
 			if errors.Is(err, api.ErrMustRetry) {
@@ -467,7 +467,7 @@ func (s *SEMP) planningRequest(id int, lp loadpoint.API) (res PlanningRequest) {
 
 	maxPowerConsumption := int(lp.EffectiveMaxPower())
 	minPowerConsumption := int(lp.EffectiveMinPower())
-	if mode == api.ModeNow {
+	if mode == api.ModeNow || mode == api.ModeBoost {
 		minPowerConsumption = maxPowerConsumption
 	}
 
@@ -0,0 +1,144 @@
+package meter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+)
+
+func init() {
+	registry.Add("aggregate", NewAggregateFromConfig)
+}
+
+// aggregateTerm is a signed reference to a named meter
+type aggregateTerm struct {
+	sign float64
+	name string
+}
+
+// parseAggregateExpression parses expressions like `grid = pv - house` into signed terms.
+// Supported operators are binary + and - as well as unary minus. The result name is optional.
+func parseAggregateExpression(expr string) ([]aggregateTerm, error) {
+	if _, rhs, ok := strings.Cut(expr, "="); ok {
+		expr = rhs
+	}
+
+	var (
+		res     []aggregateTerm
+		sign    = 1.0
+		operand = true // expecting operand
+	)
+
+	for s := strings.TrimSpace(expr); s != ""; s = strings.TrimSpace(s) {
+		switch c := rune(s[0]); {
+		case c == '+' && !operand:
+			operand = true
+			s = s[1:]
+
+		case c == '-':
+			// binary or unary minus
+			sign = -sign
+			operand = true
+			s = s[1:]
+
+		case operand && (unicode.IsLetter(c) || c == '_'):
+			i := strings.IndexFunc(s, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+			})
+			if i < 0 {
+				i = len(s)
+			}
+
+			res = append(res, aggregateTerm{sign: sign, name: s[:i]})
+			sign, operand = 1, false
+			s = s[i:]
+
+		default:
+			return nil, fmt.Errorf("invalid expression: %s", expr)
+		}
+	}
+
+	if operand {
+		return nil, fmt.Errorf("invalid expression: %s", expr)
+	}
+
+	return res, nil
+}
+
+// NewAggregateFromConfig creates a virtual meter combining the readings of multiple meters
+func NewAggregateFromConfig(other map[string]interface{}) (api.Meter, error) {
+	cc := struct {
+		Meters map[string]struct {
+			Type  string
+			Other map[string]interface{} `mapstructure:",remain"`
+		}
+		Expression string
+	}{}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	terms, err := parseAggregateExpression(cc.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	meters := make(map[string]api.Meter, len(cc.Meters))
+	for name, mc := range cc.Meters {
+		m, err := NewFromConfig(mc.Type, mc.Other)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		meters[name] = m
+	}
+
+	var (
+		powers      []func() (float64, error)
+		energies    []func() (float64, error)
+		hasEnergies = true
+	)
+
+	for _, t := range terms {
+		m, ok := meters[t.name]
+		if !ok {
+			return nil, fmt.Errorf("expression: unknown meter: %s", t.name)
+		}
+
+		powers = append(powers, m.CurrentPower)
+
+		if m, ok := m.(api.MeterEnergy); ok {
+			energies = append(energies, m.TotalEnergy)
+		} else {
+			hasEnergies = false
+		}
+	}
+
+	m, _ := NewConfigurable(aggregate(terms, powers))
+
+	// decorate energy if available for all meters
+	var totalEnergy func() (float64, error)
+	if hasEnergies {
+		totalEnergy = aggregate(terms, energies)
+	}
+
+	return m.Decorate(totalEnergy, nil, nil, nil, nil, nil, nil, nil), nil
+}
+
+// aggregate returns the signed sum of the getters
+func aggregate(terms []aggregateTerm, getters []func() (float64, error)) func() (float64, error) {
+	return func() (float64, error) {
+		var res float64
+		for i, g := range getters {
+			f, err := g()
+			if err != nil {
+				return 0, fmt.Errorf("%s: %w", terms[i].name, err)
+			}
+			res += terms[i].sign * f
+		}
+		return res, nil
+	}
+}
@@ -0,0 +1,56 @@
+package meter
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateExpression(t *testing.T) {
+	for _, tc := range []struct {
+		expr  string
+		terms []aggregateTerm
+	}{
+		{"grid = pv - house", []aggregateTerm{{1, "pv"}, {-1, "house"}}},
+		{"pv + house", []aggregateTerm{{1, "pv"}, {1, "house"}}},
+		{"-pv", []aggregateTerm{{-1, "pv"}}},
+		{"a - -b+c_2", []aggregateTerm{{1, "a"}, {1, "b"}, {1, "c_2"}}},
+	} {
+		terms, err := parseAggregateExpression(tc.expr)
+		require.NoError(t, err, tc.expr)
+		assert.Equal(t, tc.terms, terms, tc.expr)
+	}
+
+	for _, expr := range []string{"", "pv -", "pv house", "pv * house", "+pv"} {
+		_, err := parseAggregateExpression(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestAggregateMeter(t *testing.T) {
+	m, err := NewAggregateFromConfig(map[string]interface{}{
+		"expression": "grid = pv - house",
+		"meters": map[string]interface{}{
+			"pv": map[string]interface{}{
+				"type":   "custom",
+				"power":  map[string]interface{}{"source": "const", "value": 5000},
+				"energy": map[string]interface{}{"source": "const", "value": 100},
+			},
+			"house": map[string]interface{}{
+				"type":  "custom",
+				"power": map[string]interface{}{"source": "const", "value": 2000},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	f, err := m.CurrentPower()
+	require.NoError(t, err)
+	assert.Equal(t, 3000.0, f)
+
+	// energy requires all meters to provide energy
+	_, ok := m.(api.MeterEnergy)
+	assert.False(t, ok)
+}
@@ -0,0 +1,234 @@
+package meter
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/meter/obis"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+	"github.com/grid-x/serial"
+)
+
+// Dlms meter implementation reading IEC 62056-21 data telegrams from the optical interface
+type Dlms struct {
+	mu      sync.Mutex
+	log     *util.Logger
+	connect func() (io.ReadWriteCloser, error)
+	request bool
+	timeout time.Duration
+	values  map[string]float64
+	updated time.Time
+}
+
+func init() {
+	registry.Add("dlms", NewDlmsFromConfig)
+}
+
+//go:generate go run ../cmd/tools/decorate.go -f decorateDlms -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)"
+
+// NewDlmsFromConfig creates an IEC 62056-21 meter from generic config
+func NewDlmsFromConfig(other map[string]interface{}) (api.Meter, error) {
+	cc := struct {
+		URI, Device string
+		Baudrate    int
+		Comset      string
+		Request     bool
+		Timeout     time.Duration
+	}{
+		Baudrate: 9600,
+		Comset:   "7E1",
+		Timeout:  15 * time.Second,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	var connect func() (io.ReadWriteCloser, error)
+
+	switch {
+	case cc.URI != "" && cc.Device == "":
+		connect = func() (io.ReadWriteCloser, error) {
+			dialer := net.Dialer{Timeout: request.Timeout}
+			return dialer.Dial("tcp", cc.URI)
+		}
+
+	case cc.Device != "" && cc.URI == "":
+		config, err := serialConfig(cc.Device, cc.Baudrate, cc.Comset)
+		if err != nil {
+			return nil, err
+		}
+
+		connect = func() (io.ReadWriteCloser, error) {
+			return serial.Open(config)
+		}
+
+	default:
+		return nil, errors.New("must have either uri or device")
+	}
+
+	return NewDlms(connect, cc.Request, cc.Timeout)
+}
+
+// serialConfig creates the serial port configuration from the comset, e.g. 7E1
+func serialConfig(device string, baudrate int, comset string) (*serial.Config, error) {
+	if len(comset) != 3 {
+		return nil, fmt.Errorf("invalid comset: %s", comset)
+	}
+
+	dataBits, err := strconv.Atoi(comset[:1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid comset: %s", comset)
+	}
+
+	stopBits, err := strconv.Atoi(comset[2:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid comset: %s", comset)
+	}
+
+	return &serial.Config{
+		Address:  device,
+		BaudRate: baudrate,
+		DataBits: dataBits,
+		Parity:   comset[1:2],
+		StopBits: stopBits,
+	}, nil
+}
+
+// NewDlms creates an IEC 62056-21 meter. If request is set, the telegram is requested using the sign-on message
+// instead of waiting for the meter to push it.
+func NewDlms(connect func() (io.ReadWriteCloser, error), request bool, timeout time.Duration) (api.Meter, error) {
+	m := &Dlms{
+		log:     util.NewLogger("dlms"),
+		connect: connect,
+		request: request,
+		timeout: timeout,
+	}
+
+	conn, err := connect()
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(chan struct{}, 1)
+	go m.run(conn, done)
+
+	// wait for initial value
+	select {
+	case <-done:
+	case <-time.NewTimer(timeout).C:
+		return nil, os.ErrDeadlineExceeded
+	}
+
+	// decorate energy reading
+	var totalEnergy func() (float64, error)
+	if _, err := m.get(obis.EnergyConsumption); err == nil {
+		totalEnergy = m.totalEnergy
+	}
+
+	return decorateDlms(m, totalEnergy), nil
+}
+
+func (m *Dlms) run(conn io.ReadWriteCloser, done chan struct{}) {
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxInterval = 5 * time.Minute
+
+	reader := bufio.NewReader(conn)
+
+	for {
+		if conn == nil {
+			var err error
+			if conn, err = m.connect(); err != nil {
+				m.log.ERROR.Printf("connect: %v", err)
+				time.Sleep(bo.NextBackOff().Truncate(time.Second))
+				continue
+			}
+
+			reader.Reset(conn)
+		}
+
+		if m.request {
+			// sign-on message
+			if _, err := conn.Write([]byte("/?!\r\n")); err != nil {
+				m.log.ERROR.Printf("request: %v", err)
+				conn.Close()
+				conn = nil
+				continue
+			}
+		}
+
+		values, err := obis.ReadTelegram(reader)
+		if err != nil {
+			m.log.ERROR.Printf("read: %v", err)
+			conn.Close()
+			conn = nil
+			continue
+		}
+
+		bo.Reset()
+		m.log.TRACE.Printf("read: %v", values)
+
+		m.mu.Lock()
+		m.values = values
+		m.updated = time.Now()
+		m.mu.Unlock()
+
+		select {
+		case done <- struct{}{}:
+		default:
+		}
+
+		if m.request {
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+func (m *Dlms) get(code string) (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if time.Since(m.updated) > m.timeout {
+		return 0, os.ErrDeadlineExceeded
+	}
+
+	res, ok := m.values[code]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", api.ErrNotAvailable, code)
+	}
+
+	return res, nil
+}
+
+// CurrentPower implements the api.Meter interface
+func (m *Dlms) CurrentPower() (float64, error) {
+	if res, err := m.get(obis.PowerActive); !errors.Is(err, api.ErrNotAvailable) {
+		return res, err
+	}
+
+	bezug, err1 := m.get(obis.PowerImportActive)
+	lief, err2 := m.get(obis.PowerExportActive)
+
+	// allow one value to be missing
+	if err1 == nil && errors.Is(err2, api.ErrNotAvailable) || err2 == nil && errors.Is(err1, api.ErrNotAvailable) {
+		err1 = nil
+		err2 = nil
+	}
+
+	return bezug - lief, errors.Join(err1, err2)
+}
+
+// totalEnergy implements the api.MeterEnergy interface
+func (m *Dlms) totalEnergy() (float64, error) {
+	return m.get(obis.EnergyConsumption)
+}
@@ -0,0 +1,35 @@
+package meter
+
+// Code generated by github.com/evcc-io/evcc/cmd/tools/decorate.go. DO NOT EDIT.
+
+import (
+	"github.com/evcc-io/evcc/api"
+)
+
+func decorateDlms(base api.Meter, meterEnergy func() (float64, error)) api.Meter {
+	switch {
+	case meterEnergy == nil:
+		return base
+
+	case meterEnergy != nil:
+		return &struct {
+			api.Meter
+			api.MeterEnergy
+		}{
+			Meter: base,
+			MeterEnergy: &decorateDlmsMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+	}
+
+	return nil
+}
+
+type decorateDlmsMeterEnergyImpl struct {
+	meterEnergy func() (float64, error)
+}
+
+func (impl *decorateDlmsMeterEnergyImpl) TotalEnergy() (float64, error) {
+	return impl.meterEnergy()
+}
@@ -0,0 +1,136 @@
+package meter
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/modbus"
+)
+
+// finder7mRegisters contains the model specific register layout
+type finder7mRegisters struct {
+	phases   int
+	power    uint16 // int32 1W
+	voltages uint16 // uint32 0.1V per phase
+	currents uint16 // int32 1mA per phase
+	energy   uint16 // int32 1Wh
+}
+
+var finder7mModels = map[string]finder7mRegisters{
+	"7m38": {phases: 3, power: 0x0000, voltages: 0x0006, currents: 0x000C, energy: 0x0024},
+	"7m24": {phases: 1, power: 0x0000, voltages: 0x0002, currents: 0x0004, energy: 0x0014},
+}
+
+// Finder7M is a meter implementation for the Finder 7M series Modbus meters.
+// Values are 32 bit big-endian, energy is signed and becomes negative for net export.
+type Finder7M struct {
+	conn *modbus.Connection
+	regs finder7mRegisters
+}
+
+func init() {
+	registry.Add("finder-7m", NewFinder7MFromConfig)
+}
+
+// NewFinder7MFromConfig creates a Finder 7M meter from generic config
+func NewFinder7MFromConfig(other map[string]interface{}) (api.Meter, error) {
+	cc := struct {
+		modbus.Settings `mapstructure:",squash"`
+		Model           string
+	}{
+		Settings: modbus.Settings{
+			ID:       1,
+			Baudrate: 19200,
+			Comset:   "8N1",
+		},
+		Model: "7m38",
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	return NewFinder7M(cc.URI, cc.Device, cc.Comset, cc.Baudrate, cc.Protocol(), cc.ID, cc.Model)
+}
+
+// NewFinder7M creates a Finder 7M meter
+func NewFinder7M(uri, device, comset string, baudrate int, proto modbus.Protocol, id uint8, model string) (*Finder7M, error) {
+	regs, ok := finder7mModels[strings.ToLower(model)]
+	if !ok {
+		return nil, fmt.Errorf("invalid model: %s", model)
+	}
+
+	conn, err := modbus.NewConnection(uri, device, comset, baudrate, proto, id)
+	if err != nil {
+		return nil, err
+	}
+
+	log := util.NewLogger("finder")
+	conn.Logger(log.TRACE)
+
+	m := &Finder7M{
+		conn: conn,
+		regs: regs,
+	}
+
+	return m, nil
+}
+
+// CurrentPower implements the api.Meter interface
+func (m *Finder7M) CurrentPower() (float64, error) {
+	b, err := m.conn.ReadInputRegisters(m.regs.power, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(int32(binary.BigEndian.Uint32(b))), nil
+}
+
+var _ api.MeterEnergy = (*Finder7M)(nil)
+
+// TotalEnergy implements the api.MeterEnergy interface
+func (m *Finder7M) TotalEnergy() (float64, error) {
+	b, err := m.conn.ReadInputRegisters(m.regs.energy, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(int32(binary.BigEndian.Uint32(b))) / 1e3, nil
+}
+
+// getPhaseValues returns the model's sequential phase values, missing phases are zero
+func (m *Finder7M) getPhaseValues(reg uint16, signed bool, divider float64) (float64, float64, float64, error) {
+	b, err := m.conn.ReadInputRegisters(reg, uint16(2*m.regs.phases))
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	var res [3]float64
+	for i := range m.regs.phases {
+		u := binary.BigEndian.Uint32(b[4*i:])
+		if signed {
+			res[i] = float64(int32(u)) / divider
+		} else {
+			res[i] = float64(u) / divider
+		}
+	}
+
+	return res[0], res[1], res[2], nil
+}
+
+var _ api.PhaseCurrents = (*Finder7M)(nil)
+
+// Currents implements the api.PhaseCurrents interface
+func (m *Finder7M) Currents() (float64, float64, float64, error) {
+	return m.getPhaseValues(m.regs.currents, true, 1e3)
+}
+
+var _ api.PhaseVoltages = (*Finder7M)(nil)
+
+// Voltages implements the api.PhaseVoltages interface
+func (m *Finder7M) Voltages() (float64, float64, float64, error) {
+	return m.getPhaseValues(m.regs.voltages, false, 10)
+}
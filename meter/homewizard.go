@@ -1,11 +1,14 @@
 package meter
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/meter/homewizard"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
 )
 
 // HomeWizard meter implementation
@@ -18,25 +21,40 @@ func init() {
 	registry.Add("homewizard", NewHomeWizardFromConfig)
 }
 
+//go:generate go run ../cmd/tools/decorate.go -f decorateHomeWizard -b *HomeWizard -r api.Meter -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)"
+
 // NewHomeWizardFromConfig creates a HomeWizard meter from generic config
 func NewHomeWizardFromConfig(other map[string]interface{}) (api.Meter, error) {
 	cc := struct {
-		URI   string
-		Cache time.Duration
+		URI     string
+		Model   string // msm (single-phase) or msm3p (three-phase) for phase values
+		Cache   time.Duration
+		Timeout time.Duration
 	}{
-		Cache: time.Second,
+		Cache:   time.Second,
+		Timeout: request.Timeout,
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
 		return nil, err
 	}
 
-	return NewHomeWizard(cc.URI, cc.Cache)
+	return NewHomeWizard(cc.URI, cc.Model, cc.Cache, cc.Timeout)
 }
 
 // NewHomeWizard creates HomeWizard meter
-func NewHomeWizard(uri string, cache time.Duration) (*HomeWizard, error) {
-	conn, err := homewizard.NewConnection(uri, cache)
+func NewHomeWizard(uri, model string, cache, timeout time.Duration) (api.Meter, error) {
+	var phases bool
+	switch strings.ToLower(model) {
+	case "":
+	case "msm", "msm3p":
+		// single-phase meters only report L1
+		phases = true
+	default:
+		return nil, fmt.Errorf("invalid model: %s", model)
+	}
+
+	conn, err := homewizard.NewConnection(uri, cache, timeout)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +63,11 @@ func NewHomeWizard(uri string, cache time.Duration) (*HomeWizard, error) {
 		conn: conn,
 	}
 
-	return c, nil
+	if !phases {
+		return c, nil
+	}
+
+	return decorateHomeWizard(c, c.conn.Currents, c.conn.Voltages), nil
 }
 
 var _ api.Meter = (*HomeWizard)(nil)
@@ -23,7 +23,7 @@ type Connection struct {
 }
 
 // NewConnection creates a homewizard connection
-func NewConnection(uri string, cache time.Duration) (*Connection, error) {
+func NewConnection(uri string, cache, timeout time.Duration) (*Connection, error) {
 	if uri == "" {
 		return nil, errors.New("missing uri")
 	}
@@ -35,6 +35,7 @@ func NewConnection(uri string, cache time.Duration) (*Connection, error) {
 	}
 
 	c.Client.Transport = request.NewTripper(log, transport.Insecure())
+	c.Client.Timeout = timeout
 
 	// check and set API version + product type
 	var res ApiResponse
@@ -110,3 +111,15 @@ func (c *Connection) TotalEnergy() (float64, error) {
 	res, err := c.dataG.Get()
 	return res.TotalPowerImportT1kWh + res.TotalPowerImportT2kWh + res.TotalPowerImportT3kWh + res.TotalPowerImportT4kWh, err
 }
+
+// Currents implements the api.PhaseCurrents interface
+func (c *Connection) Currents() (float64, float64, float64, error) {
+	res, err := c.dataG.Get()
+	return res.ActiveCurrentL1A, res.ActiveCurrentL2A, res.ActiveCurrentL3A, err
+}
+
+// Voltages implements the api.PhaseVoltages interface
+func (c *Connection) Voltages() (float64, float64, float64, error) {
+	res, err := c.dataG.Get()
+	return res.ActiveVoltageL1V, res.ActiveVoltageL2V, res.ActiveVoltageL3V, err
+}
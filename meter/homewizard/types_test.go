@@ -44,4 +44,13 @@ func TestUnmarshalDataResponse(t *testing.T) {
 		assert.Equal(t, float64(30.511), res.TotalPowerImportT1kWh+res.TotalPowerImportT2kWh+res.TotalPowerImportT3kWh+res.TotalPowerImportT4kWh)
 		assert.Equal(t, float64(543), res.ActivePowerW)
 	}
+	{
+		var res DataResponse
+
+		jsonstr := `{"active_power_w": 1543,"active_voltage_l1_v": 230.1,"active_voltage_l2_v": 231.2,"active_voltage_l3_v": 229.8,"active_current_l1_a": 2.1,"active_current_l2_a": 3.2,"active_current_l3_a": 1.4}`
+		require.NoError(t, json.Unmarshal([]byte(jsonstr), &res))
+
+		assert.Equal(t, []float64{230.1, 231.2, 229.8}, []float64{res.ActiveVoltageL1V, res.ActiveVoltageL2V, res.ActiveVoltageL3V})
+		assert.Equal(t, []float64{2.1, 3.2, 1.4}, []float64{res.ActiveCurrentL1A, res.ActiveCurrentL2A, res.ActiveCurrentL3A})
+	}
 }
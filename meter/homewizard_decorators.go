@@ -0,0 +1,69 @@
+package meter
+
+// Code generated by github.com/evcc-io/evcc/cmd/tools/decorate.go. DO NOT EDIT.
+
+import (
+	"github.com/evcc-io/evcc/api"
+)
+
+func decorateHomeWizard(base *HomeWizard, phaseCurrents func() (float64, float64, float64, error), phaseVoltages func() (float64, float64, float64, error)) api.Meter {
+	switch {
+	case phaseCurrents == nil && phaseVoltages == nil:
+		return base
+
+	case phaseCurrents != nil && phaseVoltages == nil:
+		return &struct {
+			*HomeWizard
+			api.PhaseCurrents
+		}{
+			HomeWizard: base,
+			PhaseCurrents: &decorateHomeWizardPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case phaseCurrents == nil && phaseVoltages != nil:
+		return &struct {
+			*HomeWizard
+			api.PhaseVoltages
+		}{
+			HomeWizard: base,
+			PhaseVoltages: &decorateHomeWizardPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case phaseCurrents != nil && phaseVoltages != nil:
+		return &struct {
+			*HomeWizard
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			HomeWizard: base,
+			PhaseCurrents: &decorateHomeWizardPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateHomeWizardPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+	}
+
+	return nil
+}
+
+type decorateHomeWizardPhaseCurrentsImpl struct {
+	phaseCurrents func() (float64, float64, float64, error)
+}
+
+func (impl *decorateHomeWizardPhaseCurrentsImpl) Currents() (float64, float64, float64, error) {
+	return impl.phaseCurrents()
+}
+
+type decorateHomeWizardPhaseVoltagesImpl struct {
+	phaseVoltages func() (float64, float64, float64, error)
+}
+
+func (impl *decorateHomeWizardPhaseVoltagesImpl) Voltages() (float64, float64, float64, error) {
+	return impl.phaseVoltages()
+}
@@ -0,0 +1,21 @@
+package meter
+
+import (
+	"testing"
+
+	"go.uber.org/goleak"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m,
+		// template tests create process-wide listeners and discoverers
+		goleak.IgnoreAnyFunction("github.com/evcc-io/evcc/meter/goodwe.(*Server).listen"),
+		goleak.IgnoreAnyFunction("github.com/evcc-io/evcc/meter/goodwe.(*Server).readData"),
+		goleak.IgnoreAnyFunction("github.com/evcc-io/evcc/provider/sma.(*Device).run"),
+		goleak.IgnoreAnyFunction("github.com/evcc-io/evcc/provider/sma.(*Discoverer).run"),
+		goleak.IgnoreAnyFunction("github.com/evcc-io/evcc/provider/sma.(*Discoverer).run.func1"),
+		goleak.IgnoreAnyFunction("gitlab.com/bboehmke/sunny.(*Connection).listenLoop"),
+		// template tests don't stop socket plugins
+		goleak.IgnoreAnyFunction("github.com/evcc-io/evcc/provider.(*Socket).listen"),
+	)
+}
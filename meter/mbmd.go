@@ -15,18 +15,19 @@ import (
 
 // ModbusMbmd is an api.Meter implementation with configurable getters and setters.
 type ModbusMbmd struct {
-	conn     *modbus.Connection
-	device   meters.Device
-	opPower  modbus.Operation
-	opEnergy modbus.Operation
-	opSoc    modbus.Operation
+	conn        *modbus.Connection
+	device      meters.Device
+	opPower     modbus.Operation
+	opEnergy    modbus.Operation
+	opFrequency modbus.Operation
+	opSoc       modbus.Operation
 }
 
 func init() {
 	registry.Add("mbmd", NewModbusMbmdFromConfig)
 }
 
-//go:generate go run ../cmd/tools/decorate.go -f decorateModbusMbmd -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.PhasePowers,Powers,func() (float64, float64, float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64"
+//go:generate go run ../cmd/tools/decorate.go -f decorateModbusMbmd -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.PhasePowers,Powers,func() (float64, float64, float64, error)" -t "api.Frequency,Frequency,func() (float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64"
 
 // NewModbusMbmdFromConfig creates api.Meter from config
 func NewModbusMbmdFromConfig(other map[string]interface{}) (api.Meter, error) {
@@ -35,6 +36,7 @@ func NewModbusMbmdFromConfig(other map[string]interface{}) (api.Meter, error) {
 		capacity           `mapstructure:",squash"`
 		modbus.Settings    `mapstructure:",squash"`
 		Power, Energy, Soc string
+		Frequency          string
 		Currents           []string
 		Voltages           []string
 		Powers             []string
@@ -57,7 +59,7 @@ func NewModbusMbmdFromConfig(other map[string]interface{}) (api.Meter, error) {
 		cc.RTU = &b
 	}
 
-	conn, err := modbus.NewConnection(cc.URI, cc.Device, cc.Comset, cc.Baudrate, modbus.ProtocolFromRTU(cc.RTU), cc.ID)
+	conn, err := modbus.NewConnection(cc.URI, cc.Device, cc.Comset, cc.Baudrate, cc.Protocol(), cc.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +132,17 @@ func NewModbusMbmdFromConfig(other map[string]interface{}) (api.Meter, error) {
 		return nil, fmt.Errorf("powers: %w", err)
 	}
 
+	// decorate frequency
+	var frequency func() (float64, error)
+	if cc.Frequency != "" {
+		m.opFrequency, err = modbus.ParseOperation(device, cc.Frequency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid measurement for frequency: %s", cc.Frequency)
+		}
+
+		frequency = m.frequency
+	}
+
 	// decorate soc
 	var soc func() (float64, error)
 	if cc.Soc != "" {
@@ -141,7 +154,7 @@ func NewModbusMbmdFromConfig(other map[string]interface{}) (api.Meter, error) {
 		soc = m.soc
 	}
 
-	return decorateModbusMbmd(m, totalEnergy, currentsG, voltagesG, powersG, soc, cc.capacity.Decorator()), nil
+	return decorateModbusMbmd(m, totalEnergy, currentsG, voltagesG, powersG, frequency, soc, cc.capacity.Decorator()), nil
 }
 
 func (m *ModbusMbmd) buildPhaseProviders(readings []string) (func() (float64, float64, float64, error), error) {
@@ -212,6 +225,11 @@ func (m *ModbusMbmd) totalEnergy() (float64, error) {
 	return m.floatGetter(m.opEnergy)
 }
 
+// frequency implements the api.Frequency interface
+func (m *ModbusMbmd) frequency() (float64, error) {
+	return m.floatGetter(m.opFrequency)
+}
+
 // soc implements the api.Battery interface
 func (m *ModbusMbmd) soc() (float64, error) {
 	return m.floatGetter(m.opSoc)
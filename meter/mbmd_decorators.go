@@ -6,12 +6,12 @@ import (
 	"github.com/evcc-io/evcc/api"
 )
 
-func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), phaseCurrents func() (float64, float64, float64, error), phaseVoltages func() (float64, float64, float64, error), phasePowers func() (float64, float64, float64, error), battery func() (float64, error), batteryCapacity func() float64) api.Meter {
+func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), phaseCurrents func() (float64, float64, float64, error), phaseVoltages func() (float64, float64, float64, error), phasePowers func() (float64, float64, float64, error), frequency func() (float64, error), battery func() (float64, error), batteryCapacity func() float64) api.Meter {
 	switch {
-	case battery == nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
 		return base
 
-	case battery == nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.MeterEnergy
@@ -22,7 +22,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.PhaseCurrents
@@ -33,7 +33,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.MeterEnergy
@@ -48,7 +48,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.PhaseVoltages
@@ -59,7 +59,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.MeterEnergy
@@ -74,7 +74,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.PhaseCurrents
@@ -89,7 +89,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.MeterEnergy
@@ -108,7 +108,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.PhasePowers
@@ -119,7 +119,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.MeterEnergy
@@ -134,7 +134,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.PhaseCurrents
@@ -149,7 +149,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.MeterEnergy
@@ -168,7 +168,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.PhasePowers
@@ -183,7 +183,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.MeterEnergy
@@ -202,7 +202,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.PhaseCurrents
@@ -221,7 +221,7 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.MeterEnergy
@@ -244,57 +244,1353 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.MeterEnergy
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.PhaseCurrents
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.PhasePowers
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.MeterEnergy
+			api.PhasePowers
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.PhaseCurrents
+			api.PhasePowers
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhasePowers
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.MeterEnergy
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.PhaseCurrents
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Frequency
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.MeterEnergy
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.PhaseCurrents
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.PhasePowers
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.MeterEnergy
+			api.PhasePowers
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.PhaseCurrents
+			api.PhasePowers
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhasePowers
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.MeterEnergy
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.PhaseCurrents
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.MeterEnergy
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.PhaseCurrents
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.PhasePowers
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.MeterEnergy
+			api.PhasePowers
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.PhaseCurrents
+			api.PhasePowers
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhasePowers
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.MeterEnergy
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.Battery
+			api.Frequency
+			api.PhaseCurrents
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.Battery
+			api.Frequency
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.MeterEnergy
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.PhaseCurrents
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.PhasePowers
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.MeterEnergy
+			api.PhasePowers
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.PhaseCurrents
+			api.PhasePowers
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhasePowers
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.MeterEnergy
+			api.PhasePowers
+			api.PhaseVoltages
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.PhaseCurrents
+			api.PhasePowers
+			api.PhaseVoltages
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
 			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhasePowers
+			api.PhaseVoltages
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
 			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
+			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
+				phasePowers: phasePowers,
+			},
+			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.Frequency
+			api.MeterEnergy
+		}{
+			Meter: base,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
+			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+		return &struct {
+			api.Meter
+			api.BatteryCapacity
+			api.Frequency
 			api.PhaseCurrents
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhaseCurrents
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
@@ -304,31 +1600,39 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.PhaseVoltages
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhaseVoltages
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
@@ -338,16 +1642,20 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.PhaseCurrents
 			api.PhaseVoltages
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
@@ -357,17 +1665,21 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhaseCurrents
 			api.PhaseVoltages
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
@@ -380,31 +1692,39 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.PhasePowers
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
 				phasePowers: phasePowers,
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhasePowers
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
@@ -414,16 +1734,20 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.PhaseCurrents
 			api.PhasePowers
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
@@ -433,17 +1757,21 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhaseCurrents
 			api.PhasePowers
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
@@ -456,16 +1784,20 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.PhasePowers
 			api.PhaseVoltages
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
 				phasePowers: phasePowers,
@@ -475,17 +1807,21 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhasePowers
 			api.PhaseVoltages
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
@@ -498,17 +1834,21 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.PhaseCurrents
 			api.PhasePowers
 			api.PhaseVoltages
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
@@ -521,18 +1861,22 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+	case battery == nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
-			api.Battery
+			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhaseCurrents
 			api.PhasePowers
 			api.PhaseVoltages
 		}{
 			Meter: base,
-			Battery: &decorateModbusMbmdBatteryImpl{
-				battery: battery,
+			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
 			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
@@ -548,24 +1892,32 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.MeterEnergy
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -574,13 +1926,17 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.PhaseCurrents
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -589,14 +1945,18 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.MeterEnergy
 			api.PhaseCurrents
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -608,13 +1968,17 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.PhaseVoltages
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -623,14 +1987,18 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.MeterEnergy
 			api.PhaseVoltages
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -642,14 +2010,18 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.PhaseCurrents
 			api.PhaseVoltages
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -661,15 +2033,19 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.MeterEnergy
 			api.PhaseCurrents
 			api.PhaseVoltages
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -684,13 +2060,17 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.PhasePowers
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -699,14 +2079,18 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.MeterEnergy
 			api.PhasePowers
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -718,14 +2102,18 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.PhaseCurrents
 			api.PhasePowers
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -737,15 +2125,19 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.MeterEnergy
 			api.PhaseCurrents
 			api.PhasePowers
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -760,14 +2152,18 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.PhasePowers
 			api.PhaseVoltages
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -779,15 +2175,19 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.MeterEnergy
 			api.PhasePowers
 			api.PhaseVoltages
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -802,15 +2202,19 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.PhaseCurrents
 			api.PhasePowers
 			api.PhaseVoltages
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -825,9 +2229,10 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery == nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency == nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
+			api.Battery
 			api.BatteryCapacity
 			api.MeterEnergy
 			api.PhaseCurrents
@@ -835,6 +2240,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			api.PhaseVoltages
 		}{
 			Meter: base,
+			Battery: &decorateModbusMbmdBatteryImpl{
+				battery: battery,
+			},
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
@@ -852,11 +2260,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 		}{
 			Meter: base,
 			Battery: &decorateModbusMbmdBatteryImpl{
@@ -865,13 +2274,17 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 		}{
 			Meter: base,
@@ -881,16 +2294,20 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.PhaseCurrents
 		}{
 			Meter: base,
@@ -900,16 +2317,20 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhaseCurrents
 		}{
@@ -920,6 +2341,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
@@ -928,11 +2352,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.PhaseVoltages
 		}{
 			Meter: base,
@@ -942,16 +2367,20 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			PhaseVoltages: &decorateModbusMbmdPhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhaseVoltages
 		}{
@@ -962,6 +2391,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
@@ -970,11 +2402,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.PhaseCurrents
 			api.PhaseVoltages
 		}{
@@ -985,6 +2418,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
@@ -993,11 +2429,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers == nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhaseCurrents
 			api.PhaseVoltages
@@ -1009,6 +2446,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
@@ -1020,11 +2460,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.PhasePowers
 		}{
 			Meter: base,
@@ -1034,16 +2475,20 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
 				phasePowers: phasePowers,
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhasePowers
 		}{
@@ -1054,6 +2499,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
@@ -1062,11 +2510,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.PhaseCurrents
 			api.PhasePowers
 		}{
@@ -1077,6 +2526,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
@@ -1085,11 +2537,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages == nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhaseCurrents
 			api.PhasePowers
@@ -1101,6 +2554,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
@@ -1112,11 +2568,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.PhasePowers
 			api.PhaseVoltages
 		}{
@@ -1127,6 +2584,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			PhasePowers: &decorateModbusMbmdPhasePowersImpl{
 				phasePowers: phasePowers,
 			},
@@ -1135,11 +2595,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents == nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhasePowers
 			api.PhaseVoltages
@@ -1151,6 +2612,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
@@ -1162,11 +2626,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy == nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.PhaseCurrents
 			api.PhasePowers
 			api.PhaseVoltages
@@ -1178,6 +2643,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			PhaseCurrents: &decorateModbusMbmdPhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
@@ -1189,11 +2657,12 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			},
 		}
 
-	case battery != nil && batteryCapacity != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
+	case battery != nil && batteryCapacity != nil && frequency != nil && meterEnergy != nil && phaseCurrents != nil && phasePowers != nil && phaseVoltages != nil:
 		return &struct {
 			api.Meter
 			api.Battery
 			api.BatteryCapacity
+			api.Frequency
 			api.MeterEnergy
 			api.PhaseCurrents
 			api.PhasePowers
@@ -1206,6 +2675,9 @@ func decorateModbusMbmd(base api.Meter, meterEnergy func() (float64, error), pha
 			BatteryCapacity: &decorateModbusMbmdBatteryCapacityImpl{
 				batteryCapacity: batteryCapacity,
 			},
+			Frequency: &decorateModbusMbmdFrequencyImpl{
+				frequency: frequency,
+			},
 			MeterEnergy: &decorateModbusMbmdMeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
@@ -1240,6 +2712,14 @@ func (impl *decorateModbusMbmdBatteryCapacityImpl) Capacity() float64 {
 	return impl.batteryCapacity()
 }
 
+type decorateModbusMbmdFrequencyImpl struct {
+	frequency func() (float64, error)
+}
+
+func (impl *decorateModbusMbmdFrequencyImpl) Frequency() (float64, error) {
+	return impl.frequency()
+}
+
 type decorateModbusMbmdMeterEnergyImpl struct {
 	meterEnergy func() (float64, error)
 }
@@ -13,7 +13,7 @@ func init() {
 	registry.Add(api.Custom, NewConfigurableFromConfig)
 }
 
-//go:generate go run ../cmd/tools/decorate.go -f decorateMeter -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.PhasePowers,Powers,func() (float64, float64, float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64" -t "api.BatteryController,SetBatteryMode,func(api.BatteryMode) error"
+//go:generate go run ../cmd/tools/decorate.go -f decorateMeter -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.PhasePowers,Powers,func() (float64, float64, float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64" -t "api.BatteryController,SetBatteryMode,func(api.BatteryMode) error" -t "api.Temperature,Temperature,func() (float64, error)"
 
 // NewConfigurableFromConfig creates api.Meter from config
 func NewConfigurableFromConfig(other map[string]interface{}) (api.Meter, error) {
@@ -24,6 +24,8 @@ func NewConfigurableFromConfig(other map[string]interface{}) (api.Meter, error)
 		Voltages []provider.Config // optional
 		Powers   []provider.Config // optional
 
+		Temperature *provider.Config // optional
+
 		// battery
 		capacity    `mapstructure:",squash"`
 		battery     `mapstructure:",squash"`
@@ -84,6 +86,15 @@ func NewConfigurableFromConfig(other map[string]interface{}) (api.Meter, error)
 		}
 	}
 
+	// decorate temperature
+	var temperatureG func() (float64, error)
+	if cc.Temperature != nil {
+		temperatureG, err = provider.NewFloatGetterFromConfig(*cc.Temperature)
+		if err != nil {
+			return nil, fmt.Errorf("temperature: %w", err)
+		}
+	}
+
 	var batModeS func(api.BatteryMode) error
 
 	switch {
@@ -104,7 +115,7 @@ func NewConfigurableFromConfig(other map[string]interface{}) (api.Meter, error)
 		batModeS = cc.battery.ModeController(modeS)
 	}
 
-	res := m.Decorate(totalEnergyG, currentsG, voltagesG, powersG, socG, cc.capacity.Decorator(), batModeS)
+	res := m.Decorate(totalEnergyG, currentsG, voltagesG, powersG, socG, cc.capacity.Decorator(), batModeS, temperatureG)
 
 	return res, nil
 }
@@ -171,8 +182,9 @@ func (m *Meter) Decorate(
 	batterySoc func() (float64, error),
 	capacity func() float64,
 	setBatteryMode func(api.BatteryMode) error,
+	temperature func() (float64, error),
 ) api.Meter {
-	return decorateMeter(m, totalEnergy, currents, voltages, powers, batterySoc, capacity, setBatteryMode)
+	return decorateMeter(m, totalEnergy, currents, voltages, powers, batterySoc, capacity, setBatteryMode, temperature)
 }
 
 // CurrentPower implements the api.Meter interface
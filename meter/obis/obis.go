@@ -8,6 +8,11 @@ const (
 	PowerFeedIn       = "1-0:2.4.0"
 	EnergyFeedIn      = "1-0:2.8.0"
 
+	// instantaneous values
+	PowerActive       = "1-0:16.7.0" // import - export
+	PowerImportActive = "1-0:1.7.0"
+	PowerExportActive = "1-0:2.7.0"
+
 	PowerConsumptionL1  = "1-0:21.4.0"
 	EnergyConsumptionL1 = "1-0:21.8.0"
 	CurrentL1           = "1-0:31.4.0"
@@ -0,0 +1,88 @@
+package obis
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Normalize converts OBIS codes to the A-B:C.D.E format. Codes without medium and channel default to electricity (1-0),
+// the billing period (*255, &255) is removed. Logical names in COSEM notation (1.0.1.8.0.255) are converted as well.
+func Normalize(code string) string {
+	code = strings.TrimSpace(code)
+
+	if i := strings.IndexAny(code, "*&"); i >= 0 {
+		code = code[:i]
+	}
+
+	// COSEM logical name A.B.C.D.E.F
+	if parts := strings.Split(code, "."); !strings.ContainsAny(code, ":-") && len(parts) == 6 {
+		return fmt.Sprintf("%s-%s:%s.%s.%s", parts[0], parts[1], parts[2], parts[3], parts[4])
+	}
+
+	if !strings.Contains(code, ":") {
+		code = "1-0:" + code
+	}
+
+	return code
+}
+
+// parseValue parses a data set value like 00012345.6789*kWh, converting power to W and energy to kWh
+func parseValue(s string) (float64, error) {
+	val, unit, _ := strings.Cut(s, "*")
+
+	f, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	switch strings.ToLower(unit) {
+	case "kw":
+		f *= 1e3
+	case "wh":
+		f /= 1e3
+	}
+
+	return f, nil
+}
+
+// ReadTelegram reads an IEC 62056-21 data telegram from / to ! and returns its numeric values by normalized OBIS code.
+// Power values are returned in W, energy values in kWh.
+func ReadTelegram(r *bufio.Reader) (map[string]float64, error) {
+	// identification line
+	if _, err := r.ReadString('/'); err != nil {
+		return nil, err
+	}
+	if _, err := r.ReadString('\n'); err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]float64)
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		// mode C frames data with STX/ETX
+		line = strings.Trim(line, "\x02\r\n ")
+
+		if strings.HasPrefix(line, "!") {
+			return res, nil
+		}
+
+		code, data, ok := strings.Cut(line, "(")
+		if !ok || code == "" {
+			continue
+		}
+
+		// multiple values use the first data set
+		data, _, _ = strings.Cut(data, ")")
+
+		if f, err := parseValue(data); err == nil {
+			res[Normalize(code)] = f
+		}
+	}
+}
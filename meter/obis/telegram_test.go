@@ -0,0 +1,45 @@
+package obis
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	for code, res := range map[string]string{
+		"1-0:1.8.0*255": "1-0:1.8.0",
+		"1-0:2.8.0&255": "1-0:2.8.0",
+		"1.8.0":         "1-0:1.8.0",
+		"1.0.1.8.0.255": "1-0:1.8.0",
+		"0-0:96.1.0":    "0-0:96.1.0",
+	} {
+		assert.Equal(t, res, Normalize(code), code)
+	}
+}
+
+func TestReadTelegram(t *testing.T) {
+	telegram := "garbage/ISk5MT174-0001\r\n\r\n" +
+		"\x020-0:96.1.0*255(001ISK0012345678)\r\n" +
+		"1-0:1.8.0*255(00012345.6789*kWh)\r\n" +
+		"1-0:2.8.0*255(00001234.5000*kWh)\r\n" +
+		"1-0:16.7.0*255(-000512*W)\r\n" +
+		"1.7.0(0.250*kW)\r\n" +
+		"!\r\n"
+
+	res, err := ReadTelegram(bufio.NewReader(strings.NewReader(telegram)))
+	require.NoError(t, err)
+
+	assert.Equal(t, 12345.6789, res[EnergyConsumption])
+	assert.Equal(t, 1234.5, res[EnergyFeedIn])
+	assert.Equal(t, -512.0, res[PowerActive])
+	assert.Equal(t, 250.0, res[PowerImportActive])
+	assert.NotContains(t, res, "0-0:96.1.0") // not numeric
+
+	// incomplete telegram
+	_, err = ReadTelegram(bufio.NewReader(strings.NewReader(telegram[:40])))
+	require.Error(t, err)
+}
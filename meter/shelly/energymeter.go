@@ -4,6 +4,7 @@ import "github.com/evcc-io/evcc/api"
 
 type EnergyMeter struct {
 	*Connection
+	sub *Subscription // optional websocket push updates
 }
 
 func NewEnergyMeter(conn *Connection) *EnergyMeter {
@@ -14,10 +15,45 @@ func NewEnergyMeter(conn *Connection) *EnergyMeter {
 	return res
 }
 
+// Subscribe receives status updates via websocket, polling is used as fallback if no current updates are available
+func (sh *EnergyMeter) Subscribe() error {
+	sub, err := NewSubscription(sh.Connection)
+	if err == nil {
+		sh.sub = sub
+	}
+	return err
+}
+
+// emStatus returns the energy meter status
+func (sh *EnergyMeter) emStatus() (Gen2EmStatusResponse, error) {
+	if sh.sub != nil {
+		if res, ok := sh.sub.EmStatus(); ok {
+			return res, nil
+		}
+	}
+
+	var res Gen2EmStatusResponse
+	err := sh.Connection.execGen2Cmd("EM.GetStatus", false, &res)
+	return res, err
+}
+
+// emDataStatus returns the energy meter data status
+func (sh *EnergyMeter) emDataStatus() (Gen2EmDataStatusResponse, error) {
+	if sh.sub != nil {
+		if res, ok := sh.sub.EmDataStatus(); ok {
+			return res, nil
+		}
+	}
+
+	var res Gen2EmDataStatusResponse
+	err := sh.Connection.execGen2Cmd("EMData.GetStatus", false, &res)
+	return res, err
+}
+
 // CurrentPower implements the api.Meter interface
 func (sh *EnergyMeter) CurrentPower() (float64, error) {
-	var res Gen2EmStatusResponse
-	if err := sh.Connection.execGen2Cmd("EM.GetStatus", false, &res); err != nil {
+	res, err := sh.emStatus()
+	if err != nil {
 		return 0, err
 	}
 
@@ -26,8 +62,8 @@ func (sh *EnergyMeter) CurrentPower() (float64, error) {
 
 // TotalEnergy implements the api.Meter interface
 func (sh *EnergyMeter) TotalEnergy() (float64, error) {
-	var res Gen2EmDataStatusResponse
-	if err := sh.Connection.execGen2Cmd("EMData.GetStatus", false, &res); err != nil {
+	res, err := sh.emDataStatus()
+	if err != nil {
 		return 0, err
 	}
 
@@ -38,8 +74,8 @@ var _ api.PhaseCurrents = (*EnergyMeter)(nil)
 
 // Currents implements the api.PhaseCurrents interface
 func (sh *EnergyMeter) Currents() (float64, float64, float64, error) {
-	var res Gen2EmStatusResponse
-	if err := sh.Connection.execGen2Cmd("EM.GetStatus", false, &res); err != nil {
+	res, err := sh.emStatus()
+	if err != nil {
 		return 0, 0, 0, err
 	}
 
@@ -50,8 +86,8 @@ var _ api.PhaseVoltages = (*EnergyMeter)(nil)
 
 // Voltages implements the api.PhaseVoltages interface
 func (sh *EnergyMeter) Voltages() (float64, float64, float64, error) {
-	var res Gen2EmStatusResponse
-	if err := sh.Connection.execGen2Cmd("EM.GetStatus", false, &res); err != nil {
+	res, err := sh.emStatus()
+	if err != nil {
 		return 0, 0, 0, err
 	}
 
@@ -62,8 +98,8 @@ var _ api.PhasePowers = (*EnergyMeter)(nil)
 
 // Powers implements the api.PhasePowers interface
 func (sh *EnergyMeter) Powers() (float64, float64, float64, error) {
-	var res Gen2EmStatusResponse
-	if err := sh.Connection.execGen2Cmd("EM.GetStatus", false, &res); err != nil {
+	res, err := sh.emStatus()
+	if err != nil {
 		return 0, 0, 0, err
 	}
 
@@ -0,0 +1,177 @@
+package shelly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+const (
+	wsReadTimeout = time.Minute      // reconnect if no message received
+	wsMaxAge      = 10 * time.Second // fall back to polling for older values
+)
+
+// Subscription receives Gen2 energy meter status notifications via websocket
+// https://shelly-api-docs.shelly.cloud/gen2/General/Notifications
+type Subscription struct {
+	log       *util.Logger
+	uri       string
+	emKey     string
+	emDataKey string
+	em        *util.Monitor[Gen2EmStatusResponse]
+	emdata    *util.Monitor[Gen2EmDataStatusResponse]
+}
+
+// NewSubscription creates a websocket subscription for the Gen2 connection and starts receiving notifications
+func NewSubscription(conn *Connection) (*Subscription, error) {
+	if conn.gen < 2 {
+		return nil, fmt.Errorf("websocket requires api generation 2 or newer (%d)", conn.gen)
+	}
+
+	s := &Subscription{
+		log:       util.NewLogger("shelly"),
+		uri:       strings.Replace(conn.uri, "http", "ws", 1),
+		emKey:     fmt.Sprintf("em:%d", conn.channel),
+		emDataKey: fmt.Sprintf("emdata:%d", conn.channel),
+		em:        util.NewMonitor[Gen2EmStatusResponse](wsMaxAge),
+		emdata:    util.NewMonitor[Gen2EmDataStatusResponse](wsMaxAge),
+	}
+
+	go s.run()
+
+	return s, nil
+}
+
+// run keeps the websocket connected
+func (s *Subscription) run() {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = time.Second
+	bo.MaxInterval = time.Minute
+	bo.MaxElapsedTime = 0 // retry forever
+
+	for {
+		if err := s.listen(bo); err != nil {
+			s.log.ERROR.Printf("websocket: %v", err)
+		}
+
+		time.Sleep(bo.NextBackOff())
+	}
+}
+
+// listen connects the websocket, requests the full status and processes notifications until the connection fails
+func (s *Subscription) listen(bo backoff.BackOff) error {
+	ctx, cancel := context.WithTimeout(context.Background(), request.Timeout)
+	defer cancel()
+
+	s.log.TRACE.Printf("websocket: connecting to %s", s.uri)
+
+	conn, _, err := websocket.Dial(ctx, s.uri, nil)
+	if err != nil {
+		return err
+	}
+	defer conn.CloseNow()
+
+	// full status may exceed the default read limit
+	conn.SetReadLimit(1 << 20)
+
+	// any request registers the source for notifications
+	req := struct {
+		Id     int    `json:"id"`
+		Src    string `json:"src"`
+		Method string `json:"method"`
+	}{
+		Id:     1,
+		Src:    "evcc",
+		Method: "Shelly.GetStatus",
+	}
+
+	if err := wsjson.Write(ctx, conn, req); err != nil {
+		return err
+	}
+
+	bo.Reset()
+
+	for {
+		var frame Gen2RpcFrame
+
+		ctx, cancel := context.WithTimeout(context.Background(), wsReadTimeout)
+		err := wsjson.Read(ctx, conn, &frame)
+		cancel()
+
+		if err != nil {
+			return err
+		}
+
+		status := frame.Params
+		if frame.Result != nil {
+			status = frame.Result
+		}
+
+		if err := s.update(status); err != nil {
+			s.log.ERROR.Printf("websocket: %v", err)
+		}
+	}
+}
+
+// update merges the (partial) component status into the current values
+func (s *Subscription) update(status json.RawMessage) error {
+	if status == nil {
+		return nil
+	}
+
+	var components map[string]json.RawMessage
+	if err := json.Unmarshal(status, &components); err != nil {
+		return err
+	}
+
+	var err error
+
+	if b, ok := components[s.emKey]; ok {
+		s.em.SetFunc(func(v Gen2EmStatusResponse) Gen2EmStatusResponse {
+			err = json.Unmarshal(b, &v)
+			return v
+		})
+	}
+
+	if b, ok := components[s.emDataKey]; ok && err == nil {
+		s.emdata.SetFunc(func(v Gen2EmDataStatusResponse) Gen2EmDataStatusResponse {
+			err = json.Unmarshal(b, &v)
+			return v
+		})
+	}
+
+	return err
+}
+
+// value returns the monitored value or false if not available or outdated
+func value[T any](m *util.Monitor[T]) (T, bool) {
+	var zero T
+
+	// don't wait for the first value
+	select {
+	case <-m.Done():
+	default:
+		return zero, false
+	}
+
+	res, err := m.Get()
+	return res, err == nil
+}
+
+// EmStatus returns the current energy meter status or false if not available
+func (s *Subscription) EmStatus() (Gen2EmStatusResponse, bool) {
+	return value(s.em)
+}
+
+// EmDataStatus returns the current energy meter data status or false if not available
+func (s *Subscription) EmDataStatus() (Gen2EmDataStatusResponse, bool) {
+	return value(s.emdata)
+}
@@ -0,0 +1,62 @@
+package shelly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nhooyr.io/websocket"
+)
+
+func TestSubscription(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.CloseNow()
+
+		ctx := context.Background()
+
+		// status request
+		if _, _, err := conn.Read(ctx); err != nil {
+			return
+		}
+
+		for _, msg := range []string{
+			`{"id":1,"src":"shellypro3em","dst":"evcc","result":{"em:0":{"id":0,"a_current":1.1,"b_current":2.2,"c_current":3.3,"a_voltage":230,"total_act_power":100},"emdata:0":{"id":0,"total_act":1234}}}`,
+			`{"src":"shellypro3em","dst":"evcc","method":"NotifyStatus","params":{"ts":1700000000,"em:0":{"id":0,"total_act_power":200}}}`,
+		} {
+			if err := conn.Write(ctx, websocket.MessageText, []byte(msg)); err != nil {
+				return
+			}
+		}
+
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	sub, err := NewSubscription(&Connection{uri: srv.URL + "/rpc", gen: 2})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		res, ok := sub.EmStatus()
+		return ok && res.TotalPower == 200
+	}, time.Second, 10*time.Millisecond)
+
+	// partial notification keeps previous values
+	res, _ := sub.EmStatus()
+	assert.Equal(t, 1.1, res.CurrentA)
+	assert.Equal(t, 230.0, res.VoltageA)
+
+	data, ok := sub.EmDataStatus()
+	require.True(t, ok)
+	assert.Equal(t, 1234.0, data.TotalEnergy)
+
+	_, err = NewSubscription(&Connection{uri: srv.URL, gen: 1})
+	require.Error(t, err)
+}
@@ -1,5 +1,7 @@
 package shelly
 
+import "encoding/json"
+
 // Shelly api homepage
 // https://shelly-api-docs.shelly.cloud/#common-http-api
 type DeviceInfo struct {
@@ -20,6 +22,15 @@ type Gen2RpcPost struct {
 	Method string `json:"method"`
 }
 
+// Gen2RpcFrame is a websocket rpc response or notification
+type Gen2RpcFrame struct {
+	Id     int             `json:"id"`
+	Src    string          `json:"src"`
+	Method string          `json:"method"`
+	Result json.RawMessage `json:"result"` // response
+	Params json.RawMessage `json:"params"` // NotifyStatus, NotifyFullStatus
+}
+
 type Gen2SwitchResponse struct {
 	Output bool `json:"output"`
 }
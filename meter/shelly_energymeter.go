@@ -1,6 +1,8 @@
 package meter
 
 import (
+	"errors"
+
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/meter/shelly"
 	"github.com/evcc-io/evcc/util"
@@ -14,10 +16,11 @@ func init() {
 // NewShellyFromConfig creates a Shelly charger from generic config
 func NewShellyEnergyMeterFromConfig(other map[string]interface{}) (api.Meter, error) {
 	var cc struct {
-		URI      string
-		User     string
-		Password string
-		Channel  int
+		URI          string
+		User         string
+		Password     string
+		Channel      int
+		UseWebsocket bool
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
@@ -29,5 +32,17 @@ func NewShellyEnergyMeterFromConfig(other map[string]interface{}) (api.Meter, er
 		return nil, err
 	}
 
-	return shelly.NewEnergyMeter(conn), nil
+	res := shelly.NewEnergyMeter(conn)
+
+	if cc.UseWebsocket {
+		if cc.User != "" {
+			return nil, errors.New("websocket does not support authentication")
+		}
+
+		if err := res.Subscribe(); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
 }
@@ -27,12 +27,14 @@ func init() {
 // NewModbusFromConfig creates Modbus plugin
 func NewModbusFromConfig(other map[string]interface{}) (Provider, error) {
 	cc := struct {
-		modbus.Settings `mapstructure:",squash"`
-		Register        modbus.Register
-		Scale           float64
-		Delay           time.Duration
-		ConnectDelay    time.Duration
-		Timeout         time.Duration
+		modbus.Settings  `mapstructure:",squash"`
+		Register         modbus.Register
+		Scale            float64
+		Delay            time.Duration
+		ConnectDelay     time.Duration
+		Timeout          time.Duration
+		StaleDataTimeout time.Duration
+		TLS              *modbus.TLSSettings
 	}{
 		Scale: 1,
 	}
@@ -41,7 +43,16 @@ func NewModbusFromConfig(other map[string]interface{}) (Provider, error) {
 		return nil, err
 	}
 
-	conn, err := modbus.NewConnection(cc.URI, cc.Device, cc.Comset, cc.Baudrate, modbus.ProtocolFromRTU(cc.RTU), cc.ID)
+	var (
+		conn *modbus.Connection
+		err  error
+	)
+
+	if cc.TLS != nil {
+		conn, err = modbus.NewTLSConnection(cc.URI, cc.ID, *cc.TLS)
+	} else {
+		conn, err = modbus.NewConnection(cc.URI, cc.Device, cc.Comset, cc.Baudrate, cc.Protocol(), cc.ID)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -61,18 +72,28 @@ func NewModbusFromConfig(other map[string]interface{}) (Provider, error) {
 		conn.ConnectDelay(cc.ConnectDelay)
 	}
 
+	// set non-default stale data timeout
+	if cc.StaleDataTimeout > 0 {
+		conn.StaleDataTimeout(cc.StaleDataTimeout)
+	}
+
 	log := util.NewLogger("modbus")
 	conn.Logger(log.TRACE)
 
-	if err := cc.Register.Error(); err != nil {
+	return NewModbus(log, conn, cc.Register, cc.Scale)
+}
+
+// NewModbus creates Modbus plugin for a register on an existing connection
+func NewModbus(log *util.Logger, conn *modbus.Connection, reg modbus.Register, scale float64) (*Modbus, error) {
+	if err := reg.Error(); err != nil {
 		return nil, err
 	}
 
 	mb := &Modbus{
 		log:   log,
 		conn:  conn,
-		reg:   cc.Register,
-		scale: cc.Scale,
+		reg:   reg,
+		scale: scale,
 	}
 	return mb, nil
 }
@@ -80,13 +101,13 @@ func NewModbusFromConfig(other map[string]interface{}) (Provider, error) {
 func (m *Modbus) readBytes(op modbus.RegisterOperation) ([]byte, error) {
 	switch op.FuncCode {
 	case gridx.FuncCodeReadHoldingRegisters:
-		return m.conn.ReadHoldingRegisters(op.Addr, op.Length)
+		return modbus.IgnoreCached(m.conn.ReadHoldingRegisters(op.Addr, op.Length))
 
 	case gridx.FuncCodeReadInputRegisters:
-		return m.conn.ReadInputRegisters(op.Addr, op.Length)
+		return modbus.IgnoreCached(m.conn.ReadInputRegisters(op.Addr, op.Length))
 
 	case gridx.FuncCodeReadCoils:
-		return m.conn.ReadCoils(op.Addr, op.Length)
+		return modbus.IgnoreCached(m.conn.ReadCoils(op.Addr, op.Length))
 
 	default:
 		return nil, fmt.Errorf("invalid read function code: %d", op.FuncCode)
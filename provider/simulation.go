@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/simulation"
+)
+
+type simulationProvider struct {
+	key string
+}
+
+func init() {
+	registry.Add("simulation", NewSimulationFromConfig)
+}
+
+// NewSimulationFromConfig creates a provider reading and writing values injected by the simulation
+func NewSimulationFromConfig(other map[string]interface{}) (Provider, error) {
+	var cc struct {
+		Key string
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	if cc.Key == "" {
+		return nil, errors.New("missing key")
+	}
+
+	return &simulationProvider{key: cc.Key}, nil
+}
+
+func (o *simulationProvider) get() (string, error) {
+	val, ok := simulation.Get(o.key)
+	if !ok {
+		return "", fmt.Errorf("%s: %w", o.key, api.ErrNotAvailable)
+	}
+	return val, nil
+}
+
+var _ StringProvider = (*simulationProvider)(nil)
+
+func (o *simulationProvider) StringGetter() (func() (string, error), error) {
+	return o.get, nil
+}
+
+var _ IntProvider = (*simulationProvider)(nil)
+
+func (o *simulationProvider) IntGetter() (func() (int64, error), error) {
+	return func() (int64, error) {
+		val, err := o.get()
+		if err != nil {
+			return 0, err
+		}
+		return strconv.ParseInt(val, 10, 64)
+	}, nil
+}
+
+var _ FloatProvider = (*simulationProvider)(nil)
+
+func (o *simulationProvider) FloatGetter() (func() (float64, error), error) {
+	return func() (float64, error) {
+		val, err := o.get()
+		if err != nil {
+			return 0, err
+		}
+		return strconv.ParseFloat(val, 64)
+	}, nil
+}
+
+var _ BoolProvider = (*simulationProvider)(nil)
+
+func (o *simulationProvider) BoolGetter() (func() (bool, error), error) {
+	return func() (bool, error) {
+		val, err := o.get()
+		if err != nil {
+			return false, err
+		}
+		return strconv.ParseBool(val)
+	}, nil
+}
+
+var _ SetStringProvider = (*simulationProvider)(nil)
+
+func (o *simulationProvider) StringSetter(_ string) (func(string) error, error) {
+	return func(val string) error {
+		simulation.Set(o.key, val)
+		return nil
+	}, nil
+}
+
+var _ SetIntProvider = (*simulationProvider)(nil)
+
+func (o *simulationProvider) IntSetter(_ string) (func(int64) error, error) {
+	return func(val int64) error {
+		simulation.Set(o.key, strconv.FormatInt(val, 10))
+		return nil
+	}, nil
+}
+
+var _ SetFloatProvider = (*simulationProvider)(nil)
+
+func (o *simulationProvider) FloatSetter(_ string) (func(float64) error, error) {
+	return func(val float64) error {
+		simulation.Set(o.key, strconv.FormatFloat(val, 'f', -1, 64))
+		return nil
+	}, nil
+}
+
+var _ SetBoolProvider = (*simulationProvider)(nil)
+
+func (o *simulationProvider) BoolSetter(_ string) (func(bool) error, error) {
+	return func(val bool) error {
+		simulation.Set(o.key, strconv.FormatBool(val))
+		return nil
+	}, nil
+}
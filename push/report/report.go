@@ -0,0 +1,224 @@
+package report
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/42atomys/sprout"
+	"github.com/evcc-io/evcc/core/session"
+	"github.com/evcc-io/evcc/util"
+	"gorm.io/gorm"
+)
+
+// OnSessionEnd sends a report for each finished charging session
+const OnSessionEnd = "onSessionEnd"
+
+const defaultTemplate = `{{ range .Sessions -}}
+Session {{ .ID }}: {{ .Vehicle }} at {{ .Loadpoint }}
+  Finished: {{ .Finished.Format "2006-01-02 15:04" }}
+  Energy: {{ printf "%.2f" .Energy }} kWh
+  Cost: {{ printf "%.2f" .Cost }} {{ .Currency }}
+  Duration: {{ .Duration }}
+  Average power: {{ printf "%.1f" .AvgPower }} kW
+
+{{ else -}}
+No charging sessions.
+{{ end -}}`
+
+// Config is the email report configuration
+type Config struct {
+	Host           string
+	Port           int
+	User, Password string
+	TLS            string // tls, starttls (default) or none
+	From           string
+	To             []string
+	Subject        string
+	Template       string // text/template for the body
+	Schedule       string // onSessionEnd or daily@hh:mm
+}
+
+// Session is the report data of a charging session
+type Session struct {
+	ID                 uint
+	Loadpoint, Vehicle string
+	Created, Finished  time.Time
+	Energy             float64 // kWh
+	Cost               float64
+	Currency           string
+	Duration           time.Duration
+	AvgPower           float64 // kW
+}
+
+// Reporter sends charging session reports via email
+type Reporter struct {
+	log      *util.Logger
+	db       *gorm.DB
+	send     func(subject, body string) error
+	subject  *template.Template
+	body     *template.Template
+	currency string
+	daily    time.Duration // time of day for daily reports, negative for session end reports
+}
+
+// New creates a reporter for the sessions stored in db
+func New(cc Config, db *gorm.DB, currency string) (*Reporter, error) {
+	if db == nil {
+		return nil, errors.New("missing database")
+	}
+
+	daily, err := parseSchedule(cc.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := newSender(cc)
+	if err != nil {
+		return nil, err
+	}
+
+	if cc.Subject == "" {
+		cc.Subject = "Charging report"
+	}
+	subject, err := template.New("subject").Funcs(sprout.TxtFuncMap()).Parse(cc.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject: %w", err)
+	}
+
+	if cc.Template == "" {
+		cc.Template = defaultTemplate
+	}
+	body, err := template.New("body").Funcs(sprout.TxtFuncMap()).Parse(cc.Template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	r := &Reporter{
+		log:      util.NewLogger("report"),
+		db:       db,
+		send:     sender.Send,
+		subject:  subject,
+		body:     body,
+		currency: currency,
+		daily:    daily,
+	}
+
+	return r, nil
+}
+
+// parseSchedule returns the time of day of daily@hh:mm or -1 for onSessionEnd
+func parseSchedule(schedule string) (time.Duration, error) {
+	if schedule == "" || strings.EqualFold(schedule, OnSessionEnd) {
+		return -1, nil
+	}
+
+	if hhmm, ok := strings.CutPrefix(schedule, "daily@"); ok {
+		if t, err := time.Parse("15:04", hhmm); err == nil {
+			return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid schedule: %s", schedule)
+}
+
+// Run sends reports for finished sessions. Session end reports are sent within seconds of the session
+// being finished, daily reports contain all sessions finished since the previous report.
+func (r *Reporter) Run() {
+	since := time.Now()
+
+	if r.daily < 0 {
+		for range time.Tick(time.Second) {
+			since = r.report(since, time.Now(), true)
+		}
+	}
+
+	for {
+		next := nextDaily(time.Now(), r.daily)
+		time.Sleep(time.Until(next))
+		since = r.report(since, next, false)
+	}
+}
+
+// nextDaily returns the next occurrence of the time of day after ts
+func nextDaily(ts time.Time, tod time.Duration) time.Time {
+	day := time.Date(ts.Year(), ts.Month(), ts.Day(), 0, 0, 0, 0, ts.Location())
+	if res := day.Add(tod); res.After(ts) {
+		return res
+	}
+	return day.AddDate(0, 0, 1).Add(tod)
+}
+
+// report sends the sessions finished after since until the given time and returns the timestamp to continue from
+func (r *Reporter) report(since, until time.Time, single bool) time.Time {
+	var res session.Sessions
+	if err := r.db.Order("finished").Find(&res, "finished > ? AND finished <= ?", since, until).Error; err != nil {
+		r.log.ERROR.Println("sessions:", err)
+		return since
+	}
+
+	if single {
+		for _, s := range res {
+			go r.dispatch([]Session{r.reportSession(s)})
+			since = s.Finished
+		}
+		return since
+	}
+
+	sessions := make([]Session, 0, len(res))
+	for _, s := range res {
+		sessions = append(sessions, r.reportSession(s))
+	}
+	go r.dispatch(sessions)
+
+	return until
+}
+
+func (r *Reporter) reportSession(s session.Session) Session {
+	res := Session{
+		ID:        s.ID,
+		Loadpoint: s.Loadpoint,
+		Vehicle:   s.Vehicle,
+		Created:   s.Created,
+		Finished:  s.Finished,
+		Energy:    s.ChargedEnergy,
+		Currency:  r.currency,
+	}
+
+	if s.Price != nil {
+		res.Cost = *s.Price
+	}
+
+	if s.ChargeDuration != nil {
+		res.Duration = s.ChargeDuration.Round(time.Second)
+		if hours := s.ChargeDuration.Hours(); hours > 0 {
+			res.AvgPower = s.ChargedEnergy / hours
+		}
+	}
+
+	return res
+}
+
+// dispatch renders and sends the report
+func (r *Reporter) dispatch(sessions []Session) {
+	data := map[string]any{
+		"Sessions": sessions,
+	}
+
+	var subject, body bytes.Buffer
+	if err := r.subject.Execute(&subject, data); err != nil {
+		r.log.ERROR.Println("subject:", err)
+		return
+	}
+	if err := r.body.Execute(&body, data); err != nil {
+		r.log.ERROR.Println("template:", err)
+		return
+	}
+
+	if err := r.send(subject.String(), body.String()); err != nil {
+		r.log.ERROR.Println("send:", err)
+	}
+}
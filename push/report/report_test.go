@@ -0,0 +1,94 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/core/session"
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	for _, tc := range []struct {
+		schedule string
+		res      time.Duration
+		err      bool
+	}{
+		{"", -1, false},
+		{"onSessionEnd", -1, false},
+		{"daily@07:00", 7 * time.Hour, false},
+		{"daily@23:30", 23*time.Hour + 30*time.Minute, false},
+		{"daily@25:00", 0, true},
+		{"weekly", 0, true},
+	} {
+		res, err := parseSchedule(tc.schedule)
+		if tc.err {
+			assert.Error(t, err, tc.schedule)
+			continue
+		}
+		require.NoError(t, err, tc.schedule)
+		assert.Equal(t, tc.res, res, tc.schedule)
+	}
+}
+
+func TestNextDaily(t *testing.T) {
+	ts := time.Date(2024, 1, 1, 8, 0, 0, 0, time.Local)
+	assert.Equal(t, time.Date(2024, 1, 2, 7, 0, 0, 0, time.Local), nextDaily(ts, 7*time.Hour))
+	assert.Equal(t, time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local), nextDaily(ts, 9*time.Hour))
+}
+
+func TestReportSessionEnd(t *testing.T) {
+	gdb, err := db.New("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	store, err := session.NewStore("garage", gdb)
+	require.NoError(t, err)
+
+	since := time.Now()
+	duration := 2 * time.Hour
+	price := 3.5
+
+	s := store.New(0)
+	s.Vehicle = "blue car"
+	s.Created = since.Add(-duration)
+	s.Finished = since.Add(time.Second)
+	s.ChargedEnergy = 22
+	s.ChargeDuration = &duration
+	s.Price = &price
+	store.Persist(s)
+
+	r, err := New(Config{Host: "localhost", From: "evcc@example.org", To: []string{"fleet@example.org"}}, gdb, "EUR")
+	require.NoError(t, err)
+
+	type msg struct{ subject, body string }
+	sent := make(chan msg, 1)
+	r.send = func(subject, body string) error {
+		sent <- msg{subject, body}
+		return nil
+	}
+
+	next := r.report(since, since.Add(time.Minute), true)
+	assert.Equal(t, s.Finished.Unix(), next.Unix())
+
+	select {
+	case m := <-sent:
+		assert.Equal(t, "Charging report", m.subject)
+		assert.Contains(t, m.body, "blue car at garage")
+		assert.Contains(t, m.body, "Energy: 22.00 kWh")
+		assert.Contains(t, m.body, "Cost: 3.50 EUR")
+		assert.Contains(t, m.body, "Duration: 2h0m0s")
+		assert.Contains(t, m.body, "Average power: 11.0 kW")
+	case <-time.After(time.Second):
+		t.Fatal("no report sent")
+	}
+
+	// already reported
+	r.report(next, since.Add(time.Minute), true)
+	select {
+	case <-sent:
+		t.Fatal("duplicate report")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
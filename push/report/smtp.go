@@ -0,0 +1,132 @@
+package report
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/util/request"
+)
+
+type sender struct {
+	addr, host     string
+	user, password string
+	tls            string
+	from           string
+	to             []string
+}
+
+func newSender(cc Config) (*sender, error) {
+	if cc.Host == "" {
+		return nil, errors.New("missing host")
+	}
+	if cc.From == "" {
+		return nil, errors.New("missing from")
+	}
+	if len(cc.To) == 0 {
+		return nil, errors.New("missing to")
+	}
+
+	switch strings.ToLower(cc.TLS) {
+	case "":
+		cc.TLS = "starttls"
+	case "tls", "starttls", "none":
+	default:
+		return nil, fmt.Errorf("invalid tls: %s", cc.TLS)
+	}
+
+	if cc.Port == 0 {
+		cc.Port = 587
+		if strings.EqualFold(cc.TLS, "tls") {
+			cc.Port = 465
+		}
+	}
+
+	s := &sender{
+		addr:     net.JoinHostPort(cc.Host, strconv.Itoa(cc.Port)),
+		host:     cc.Host,
+		user:     cc.User,
+		password: cc.Password,
+		tls:      strings.ToLower(cc.TLS),
+		from:     cc.From,
+		to:       cc.To,
+	}
+
+	return s, nil
+}
+
+// Send sends the message to all recipients
+func (s *sender) Send(subject, body string) error {
+	dialer := &net.Dialer{Timeout: request.Timeout}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	if s.tls == "tls" {
+		conn, err = tls.DialWithDialer(dialer, "tcp", s.addr, &tls.Config{ServerName: s.host})
+	} else {
+		conn, err = dialer.Dial("tcp", s.addr)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := conn.SetDeadline(time.Now().Add(time.Minute)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if s.tls == "starttls" {
+		if err := c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+
+	if s.user != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.user, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(s.from); err != nil {
+		return err
+	}
+
+	for _, to := range s.to {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nDate: %s\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n%s",
+		s.from, strings.Join(s.to, ", "), subject, time.Now().Format(time.RFC1123Z), strings.ReplaceAll(body, "\n", "\r\n"))
+
+	if _, err := w.Write([]byte(msg)); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
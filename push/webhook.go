@@ -0,0 +1,85 @@
+package push
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+)
+
+func init() {
+	registry.Add("webhook", NewWebhookFromConfig)
+}
+
+// webhookRetryDelay is the delay before a failed webhook is retried once
+const webhookRetryDelay = 5 * time.Second
+
+// Webhook implements http webhook notifications. The rendered event message is used as request body.
+type Webhook struct {
+	*request.Helper
+	log     *util.Logger
+	uri     string
+	method  string
+	headers map[string]string
+}
+
+// NewWebhookFromConfig creates new Webhook messenger
+func NewWebhookFromConfig(other map[string]interface{}) (Messenger, error) {
+	cc := struct {
+		URI     string
+		Method  string
+		Headers map[string]string
+		Timeout time.Duration
+	}{
+		Method:  "POST",
+		Timeout: request.Timeout,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	if cc.URI == "" {
+		return nil, errors.New("missing uri")
+	}
+
+	log := util.NewLogger("webhook")
+
+	m := &Webhook{
+		Helper:  request.NewHelper(log),
+		log:     log,
+		uri:     cc.URI,
+		method:  strings.ToUpper(cc.Method),
+		headers: cc.Headers,
+	}
+
+	m.Client.Timeout = cc.Timeout
+
+	return m, nil
+}
+
+// send executes the webhook request
+func (m *Webhook) send(msg string) error {
+	req, err := request.New(m.method, m.uri, strings.NewReader(msg), m.headers)
+	if err == nil {
+		_, err = m.DoBody(req)
+	}
+	return err
+}
+
+// Send calls the webhook, retrying once on failure
+func (m *Webhook) Send(title, msg string) {
+	err := m.send(msg)
+	if err == nil {
+		return
+	}
+
+	m.log.WARN.Printf("%s: %v, retrying in %v", title, err, webhookRetryDelay)
+	time.Sleep(webhookRetryDelay)
+
+	if err := m.send(msg); err != nil {
+		m.log.WARN.Printf("%s: %v", title, err)
+	}
+}
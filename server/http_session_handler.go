@@ -7,89 +7,191 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
-	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/session"
 	"github.com/evcc-io/evcc/server/db"
 	"github.com/evcc-io/evcc/util/locale"
 	"github.com/gorilla/mux"
 	"golang.org/x/text/language"
+	"gorm.io/gorm"
 )
 
-func csvResult(ctx context.Context, w http.ResponseWriter, res any, filename string) {
-	w.Header().Set("Content-Type", "text/csv")
-	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.csv"`)
-
-	if ww, ok := res.(api.CsvWriter); ok {
-		_ = ww.WriteCsv(ctx, w)
-	} else {
-		w.WriteHeader(http.StatusInternalServerError)
-	}
-}
-
-// sessionHandler returns the list of charging sessions
-func sessionHandler(w http.ResponseWriter, r *http.Request) {
-	if db.Instance == nil {
-		jsonError(w, http.StatusBadRequest, errors.New("database offline"))
-		return
-	}
-
+// sessionQuery applies the request's session filters
+func sessionQuery(r *http.Request) (*gorm.DB, string, error) {
 	var (
-		res  session.Sessions
 		cond []string
 		args []any
 	)
 
-	push := func(field, val string) {
+	push := func(field string, val any) {
 		cond = append(cond, field)
 		args = append(args, val)
 	}
 
+	q := r.URL.Query()
+
 	filename := "session"
-	if year := r.URL.Query().Get("year"); year != "" {
+	if year := q.Get("year"); year != "" {
 		filename += "-" + year
 		push("STRFTIME('%Y', created) LIKE ?", year)
 
-		if month := fmt.Sprintf("%02s", r.URL.Query().Get("month")); month != "00" {
+		if month := fmt.Sprintf("%02s", q.Get("month")); month != "00" {
 			filename += "-" + month
 			push("STRFTIME('%m', created) LIKE ?", month)
 		}
 	}
 
-	// TODO support other databases than Sqlite
-	query := strings.Join(append([]string{"charged_kwh>=0.05"}, cond...), " AND ")
-	if txn := db.Instance.Where(query, args...).Order("created DESC").Find(&res); txn.Error != nil {
-		jsonError(w, http.StatusInternalServerError, txn.Error)
-		return
+	for _, v := range []struct {
+		key, cond string
+		days      int
+	}{
+		{"from", "created >= ?", 0},
+		{"to", "created < ?", 1}, // inclusive end date
+	} {
+		if val := q.Get(v.key); val != "" {
+			t, err := time.ParseInLocation(time.DateOnly, val, time.Local)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid %s: %s", v.key, val)
+			}
+			push(v.cond, t.AddDate(0, 0, v.days))
+		}
+	}
+
+	if loadpoint := q.Get("loadpoint"); loadpoint != "" {
+		push("loadpoint = ?", loadpoint)
+	}
+
+	if vehicle := q.Get("vehicle"); vehicle != "" {
+		push("vehicle = ?", vehicle)
 	}
 
-	// prepare data
-	for i, s := range res {
-		if s.Odometer != nil {
-			odo := math.Round(*s.Odometer*10) / 10
-			res[i].Odometer = &odo
+	minEnergy := 0.05
+	if val := q.Get("minEnergy"); val != "" {
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid minEnergy: %s", val)
 		}
+		minEnergy = max(minEnergy, f)
+	}
+
+	// TODO support other databases than Sqlite
+	query := strings.Join(append([]string{"charged_kwh >= ?"}, cond...), " AND ")
+	return db.Instance.Model(new(session.Session)).Where(query, append([]any{minEnergy}, args...)...).Order("created DESC"), filename, nil
+}
+
+// roundSession rounds the session's distances for presentation
+func roundSession(s *session.Session) {
+	if s.Odometer != nil {
+		odo := math.Round(*s.Odometer*10) / 10
+		s.Odometer = &odo
+	}
+	if s.Distance != nil {
+		distance := math.Round(*s.Distance*10) / 10
+		s.Distance = &distance
+	}
+}
+
+// sessionHandler returns the list of charging sessions
+func sessionHandler(w http.ResponseWriter, r *http.Request) {
+	if db.Instance == nil {
+		jsonError(w, http.StatusBadRequest, errors.New("database offline"))
+		return
 	}
 
 	if r.URL.Query().Get("format") == "csv" {
-		lang := r.URL.Query().Get("lang")
-		if lang == "" {
-			// get request language
-			lang = r.Header.Get("Accept-Language")
-			if tags, _, err := language.ParseAcceptLanguage(lang); err == nil && len(tags) > 0 {
-				lang = tags[0].String()
-			}
+		sessionExportHandler(w, r)
+		return
+	}
+
+	txn, _, err := sessionQuery(r)
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// paginate if requested
+	if pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize")); err == nil && pageSize > 0 {
+		var total int64
+		if err := txn.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+			jsonError(w, http.StatusInternalServerError, err)
+			return
+		}
+		w.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
 		}
 
-		ctx := context.WithValue(context.Background(), locale.Locale, lang)
-		csvResult(ctx, w, &res, filename)
+		txn = txn.Limit(pageSize).Offset((page - 1) * pageSize)
+	}
+
+	var res session.Sessions
+	if err := txn.Find(&res).Error; err != nil {
+		jsonError(w, http.StatusInternalServerError, err)
 		return
 	}
 
+	for i := range res {
+		roundSession(&res[i])
+	}
+
 	jsonResult(w, res)
 }
 
+// sessionExportHandler streams the filtered charging sessions as CSV
+func sessionExportHandler(w http.ResponseWriter, r *http.Request) {
+	if db.Instance == nil {
+		jsonError(w, http.StatusBadRequest, errors.New("database offline"))
+		return
+	}
+
+	txn, filename, err := sessionQuery(r)
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	rows, err := txn.Rows()
+	if err != nil {
+		jsonError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer rows.Close()
+
+	lang := r.URL.Query().Get("lang")
+	if lang == "" {
+		// get request language
+		lang = r.Header.Get("Accept-Language")
+		if tags, _, err := language.ParseAcceptLanguage(lang); err == nil && len(tags) > 0 {
+			lang = tags[0].String()
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.csv"`)
+
+	ctx := context.WithValue(context.Background(), locale.Locale, lang)
+	_ = session.WriteCsvFunc(ctx, w, func(yield func(session.Session) error) error {
+		for rows.Next() {
+			var s session.Session
+			if err := txn.ScanRows(rows, &s); err != nil {
+				return err
+			}
+
+			roundSession(&s)
+
+			if err := yield(s); err != nil {
+				return err
+			}
+		}
+		return rows.Err()
+	})
+}
+
 // deleteSessionHandler removes session in sessions table with given id
 func deleteSessionHandler(w http.ResponseWriter, r *http.Request) {
 	if db.Instance == nil {
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/core/session"
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/evcc-io/evcc/util/locale"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestSessionHandler(t *testing.T) {
+	// empty bundle, csv captions fall back to field tags
+	locale.Bundle = i18n.NewBundle(language.English)
+	locale.Localizer = i18n.NewLocalizer(locale.Bundle)
+
+	var err error
+	db.Instance, err = db.New("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	// single connection to keep the in-memory database
+	sqlDB, err := db.Instance.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	store, err := session.NewStore("garage", db.Instance)
+	require.NoError(t, err)
+
+	for i, day := range []int{1, 2, 3, 4} {
+		s := store.New(0)
+		s.Created = time.Date(2024, 1, day, 12, 0, 0, 0, time.Local)
+		s.Vehicle = "car"
+		s.ChargedEnergy = float64(10 * (i + 1))
+		store.Persist(s)
+	}
+
+	get := func(uri string) session.Sessions {
+		w := httptest.NewRecorder()
+		sessionHandler(w, httptest.NewRequest("GET", uri, nil))
+		require.Equal(t, 200, w.Code, w.Body.String())
+
+		var res struct {
+			Result session.Sessions
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		return res.Result
+	}
+
+	res := get("/sessions?from=2024-01-02&to=2024-01-03")
+	require.Len(t, res, 2)
+	assert.Equal(t, 30.0, res[0].ChargedEnergy)
+	assert.Equal(t, 20.0, res[1].ChargedEnergy)
+
+	res = get("/sessions?loadpoint=garage&minEnergy=15&page=2&pageSize=2")
+	require.Len(t, res, 1)
+	assert.Equal(t, 20.0, res[0].ChargedEnergy)
+
+	assert.Empty(t, get("/sessions?vehicle=other"))
+
+	w := httptest.NewRecorder()
+	sessionExportHandler(w, httptest.NewRequest("GET", "/sessions/export.csv?from=2024-01-04&lang=en", nil))
+	require.Equal(t, 200, w.Code)
+
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], "ID,Created")
+	assert.True(t, strings.HasPrefix(lines[1], "4,2024-01-04 12:00:00,"), lines[1])
+}
@@ -240,6 +240,19 @@ func tariffHandler(site site.API) http.HandlerFunc {
 	}
 }
 
+// gridProtectionResetHandler confirms resuming charging after a grid protection relay fault
+func gridProtectionResetHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := site.ResetGridProtection(); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		res := struct{}{}
+		jsonResult(w, res)
+	}
+}
+
 // socketHandler attaches websocket handler to uri
 func socketHandler(hub *SocketHub) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -14,6 +14,7 @@ import (
 	"github.com/evcc-io/evcc/core/vehicle"
 	"github.com/evcc-io/evcc/provider/mqtt"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/auth"
 )
 
 // MQTT is the MQTT server. It uses the MQTT client for publishing.
@@ -21,15 +22,17 @@ type MQTT struct {
 	log       *util.Logger
 	Handler   *mqtt.Client
 	root      string
+	auth      auth.Auth // optional, requires authorized setter commands
 	publisher func(topic string, retained bool, payload string)
 }
 
-// NewMQTT creates MQTT server
-func NewMQTT(root string, site site.API) (*MQTT, error) {
+// NewMQTT creates MQTT server. If auth is given, setter commands must contain a valid api token.
+func NewMQTT(root string, site site.API, auth auth.Auth) (*MQTT, error) {
 	m := &MQTT{
 		log:     util.NewLogger("mqtt"),
 		Handler: mqtt.Instance,
 		root:    root,
+		auth:    auth,
 	}
 	m.publisher = m.publishString
 
@@ -144,6 +147,14 @@ func (m *MQTT) publish(topic string, retained bool, payload interface{}) {
 	m.publishComplex(topic, retained, payload)
 }
 
+// listenSetter listens for setter commands, ensuring authorization if required
+func (m *MQTT) listenSetter(topic string, fun func(string) error) error {
+	if m.auth != nil {
+		fun = authorizedSetter(m.auth, fun)
+	}
+	return m.Handler.ListenSetter(topic, fun)
+}
+
 func (m *MQTT) Listen(site site.API) error {
 	if err := m.listenSiteSetters(m.root+"/site", site); err != nil {
 		return err
@@ -175,7 +186,7 @@ func (m *MQTT) listenSiteSetters(topic string, site site.API) error {
 		{"/bufferStartSoc", floatSetter(site.SetBufferStartSoc)},
 		{"/residualPower", floatSetter(site.SetResidualPower)},
 	} {
-		if err := m.Handler.ListenSetter(topic+s.topic, s.fun); err != nil {
+		if err := m.listenSetter(topic+s.topic, s.fun); err != nil {
 			return err
 		}
 	}
@@ -190,6 +201,14 @@ func (m *MQTT) listenLoadpointSetters(topic string, site site.API, lp loadpoint.
 		{"/limitSoc", intSetter(pass(lp.SetLimitSoc))},
 		{"/minCurrent", floatSetter(lp.SetMinCurrent)},
 		{"/maxCurrent", floatSetter(lp.SetMaxCurrent)},
+		{"/enabled", boolSetter(func(enable bool) error {
+			demand := loadpoint.RemoteEnable
+			if !enable {
+				demand = loadpoint.RemoteHardDisable
+			}
+			lp.RemoteControl("mqtt", demand)
+			return nil
+		})},
 		{"/limitEnergy", floatSetter(pass(lp.SetLimitEnergy))},
 		{"/enableThreshold", floatSetter(pass(lp.SetEnableThreshold))},
 		{"/disableThreshold", floatSetter(pass(lp.SetDisableThreshold))},
@@ -218,7 +237,7 @@ func (m *MQTT) listenLoadpointSetters(topic string, site site.API, lp loadpoint.
 			return err
 		}},
 	} {
-		if err := m.Handler.ListenSetter(topic+s.topic, s.fun); err != nil {
+		if err := m.listenSetter(topic+s.topic, s.fun); err != nil {
 			return err
 		}
 	}
@@ -242,7 +261,7 @@ func (m *MQTT) listenVehicleSetters(topic string, v vehicle.API) error {
 			return err
 		}},
 	} {
-		if err := m.Handler.ListenSetter(s.topic, s.fun); err != nil {
+		if err := m.listenSetter(s.topic, s.fun); err != nil {
 			return err
 		}
 	}
@@ -1,7 +1,11 @@
 package server
 
 import (
+	"encoding/json"
+	"errors"
 	"strconv"
+
+	"github.com/evcc-io/evcc/util/auth"
 )
 
 type setter struct {
@@ -26,3 +30,33 @@ func floatSetter(set func(float64) error) func(string) error {
 func intSetter(set func(int) error) func(string) error {
 	return setterFunc(strconv.Atoi, set)
 }
+
+func boolSetter(set func(bool) error) func(string) error {
+	return setterFunc(strconv.ParseBool, set)
+}
+
+// authorizedSetter requires the payload to contain a valid api token: {"token": "...", "value": ...}
+func authorizedSetter(auth auth.Auth, set func(string) error) func(string) error {
+	return func(payload string) error {
+		var cmd struct {
+			Token string          `json:"token"`
+			Value json.RawMessage `json:"value"`
+		}
+
+		if err := json.Unmarshal([]byte(payload), &cmd); err != nil {
+			return err
+		}
+
+		if ok, err := auth.ValidateJwtToken(cmd.Token); !ok || err != nil {
+			return errors.New("unauthorized")
+		}
+
+		// unquote string values
+		var value string
+		if err := json.Unmarshal(cmd.Value, &value); err != nil {
+			value = string(cmd.Value)
+		}
+
+		return set(value)
+	}
+}
@@ -6,8 +6,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util/auth"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func TestMqttNaNInf(t *testing.T) {
@@ -54,3 +58,29 @@ func TestPublishTypes(t *testing.T) {
 	assert.Equal(t, []string{`2`, `10`, `20`}, payloads, "slice mismatch")
 	reset()
 }
+
+func TestAuthorizedSetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mock := settings.NewMockAPI(ctrl)
+	mock.EXPECT().String(keys.JwtSecret).Return("somesecret", nil).AnyTimes()
+	auth := auth.NewMock(mock)
+
+	token, err := auth.GenerateJwtToken(time.Hour)
+	require.NoError(t, err)
+
+	var res float64
+	set := authorizedSetter(auth, floatSetter(func(f float64) error {
+		res = f
+		return nil
+	}))
+
+	require.Error(t, set(`16`))
+	require.Error(t, set(`{"token":"invalid","value":16}`))
+
+	require.NoError(t, set(`{"token":"`+token+`","value":16}`))
+	assert.Equal(t, 16.0, res)
+
+	require.NoError(t, set(`{"token":"`+token+`","value":"10"}`))
+	assert.Equal(t, 10.0, res)
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusLoadpoint contains the label values of a loadpoint's metrics
+type PrometheusLoadpoint struct {
+	Title, Charger, Type string
+}
+
+// Prometheus exposes site and loadpoint values as Prometheus gauges
+type Prometheus struct {
+	loadpoints []PrometheusLoadpoint
+	connected  []bool
+	charging   []bool
+
+	site   map[string]prometheus.Gauge
+	lp     map[string]*prometheus.GaugeVec
+	phases map[string]*prometheus.GaugeVec
+	status *prometheus.GaugeVec
+}
+
+// NewPrometheus creates and registers the site and loadpoint gauges
+func NewPrometheus(reg prometheus.Registerer, loadpoints []PrometheusLoadpoint) (*Prometheus, error) {
+	lpLabels := []string{"loadpoint", "charger", "type"}
+
+	siteGauge := func(name, help string) prometheus.Gauge {
+		return prometheus.NewGauge(prometheus.GaugeOpts{Namespace: "evcc", Subsystem: "site", Name: name, Help: help})
+	}
+
+	lpGauge := func(name, help string, labels ...string) *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{Namespace: "evcc", Subsystem: "loadpoint", Name: name, Help: help}, append(lpLabels, labels...))
+	}
+
+	p := &Prometheus{
+		loadpoints: loadpoints,
+		connected:  make([]bool, len(loadpoints)),
+		charging:   make([]bool, len(loadpoints)),
+
+		site: map[string]prometheus.Gauge{
+			keys.PvPower:    siteGauge("pv_power_watts", "PV power"),
+			keys.GridPower:  siteGauge("grid_power_watts", "Grid power, positive for import"),
+			keys.HomePower:  siteGauge("home_power_watts", "Home power"),
+			keys.BatterySoc: siteGauge("battery_soc_percent", "Battery state of charge"),
+		},
+		lp: map[string]*prometheus.GaugeVec{
+			keys.ChargePower:       lpGauge("charge_power_watts", "Charge power"),
+			keys.ChargedEnergy:     lpGauge("session_energy_wh", "Charged energy of the current session"),
+			keys.ChargeTotalImport: lpGauge("charged_energy_kwh", "Charge meter total import"),
+			keys.Enabled:           lpGauge("enabled", "Charger enabled"),
+		},
+		phases: map[string]*prometheus.GaugeVec{
+			keys.ChargeCurrents: lpGauge("charge_current_amperes", "Charge current per phase", "phase"),
+			keys.ChargeVoltages: lpGauge("charge_voltage_volts", "Charge voltage per phase", "phase"),
+		},
+		status: lpGauge("status", "Charge status, 0: disconnected, 1: connected, 2: charging"),
+	}
+
+	collectors := []prometheus.Collector{p.status}
+	for _, g := range p.site {
+		collectors = append(collectors, g)
+	}
+	for _, g := range p.lp {
+		collectors = append(collectors, g)
+	}
+	for _, g := range p.phases {
+		collectors = append(collectors, g)
+	}
+
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// Run updates the gauges from the published values
+func (p *Prometheus) Run(in <-chan util.Param) {
+	for param := range in {
+		if param.Loadpoint == nil {
+			if g, ok := p.site[param.Key]; ok {
+				if f, ok := prometheusValue(param.Val); ok {
+					g.Set(f)
+				}
+			}
+			continue
+		}
+
+		id := *param.Loadpoint
+		if id < 0 || id >= len(p.loadpoints) {
+			continue
+		}
+
+		lp := p.loadpoints[id]
+		labels := prometheus.Labels{"loadpoint": lp.Title, "charger": lp.Charger, "type": lp.Type}
+
+		switch param.Key {
+		case keys.Connected, keys.Charging:
+			v, _ := param.Val.(bool)
+			if param.Key == keys.Connected {
+				p.connected[id] = v
+			} else {
+				p.charging[id] = v
+			}
+
+			var status float64
+			switch {
+			case p.charging[id]:
+				status = 2
+			case p.connected[id]:
+				status = 1
+			}
+			p.status.With(labels).Set(status)
+
+		case keys.ChargeCurrents, keys.ChargeVoltages:
+			vals, _ := param.Val.([]float64)
+			for i, v := range vals {
+				labels["phase"] = "L" + strconv.Itoa(i+1)
+				p.phases[param.Key].With(labels).Set(v)
+			}
+
+		default:
+			if g, ok := p.lp[param.Key]; ok {
+				if f, ok := prometheusValue(param.Val); ok {
+					g.With(labels).Set(f)
+				}
+			}
+		}
+	}
+}
+
+// prometheusValue converts a published value to a gauge value
+func prometheusValue(val any) (float64, bool) {
+	switch v := val.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheus(t *testing.T) {
+	p, err := NewPrometheus(prometheus.NewRegistry(), []PrometheusLoadpoint{{Title: "Garage", Charger: "wallbox", Type: "demo-charger"}})
+	require.NoError(t, err)
+
+	lp := 0
+	in := make(chan util.Param)
+	done := make(chan struct{})
+
+	go func() {
+		p.Run(in)
+		close(done)
+	}()
+
+	in <- util.Param{Key: keys.PvPower, Val: 4200.0}
+	in <- util.Param{Key: keys.BatterySoc, Val: 55}
+	in <- util.Param{Loadpoint: &lp, Key: keys.ChargePower, Val: 11000.0}
+	in <- util.Param{Loadpoint: &lp, Key: keys.Enabled, Val: true}
+	in <- util.Param{Loadpoint: &lp, Key: keys.Connected, Val: true}
+	in <- util.Param{Loadpoint: &lp, Key: keys.Charging, Val: true}
+	in <- util.Param{Loadpoint: &lp, Key: keys.ChargeCurrents, Val: []float64{16, 16, 15}}
+	close(in)
+	<-done
+
+	labels := prometheus.Labels{"loadpoint": "Garage", "charger": "wallbox", "type": "demo-charger"}
+
+	assert.Equal(t, 4200.0, testutil.ToFloat64(p.site[keys.PvPower]))
+	assert.Equal(t, 55.0, testutil.ToFloat64(p.site[keys.BatterySoc]))
+	assert.Equal(t, 11000.0, testutil.ToFloat64(p.lp[keys.ChargePower].With(labels)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(p.lp[keys.Enabled].With(labels)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(p.status.With(labels)))
+
+	labels["phase"] = "L3"
+	assert.Equal(t, 15.0, testutil.ToFloat64(p.phases[keys.ChargeCurrents].With(labels)))
+}
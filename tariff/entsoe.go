@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/xml"
 	"errors"
-	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -166,11 +165,7 @@ func (t *Entsoe) run(done chan error) {
 
 // Rates implements the api.Tariff interface
 func (t *Entsoe) Rates() (api.Rates, error) {
-	var res api.Rates
-	err := t.data.GetFunc(func(val api.Rates) {
-		res = slices.Clone(val)
-	})
-	return res, err
+	return cachedRates(t.log, t.data)
 }
 
 // Type implements the api.Tariff interface
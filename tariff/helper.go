@@ -2,9 +2,12 @@ package tariff
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/request"
 )
 
@@ -25,3 +28,19 @@ func backoffPermanentError(err error) error {
 	}
 	return err
 }
+
+// cachedRates returns a copy of the last successfully fetched rates. If updating the rates has failed for longer
+// than the monitor timeout, the outdated rates are returned with a warning instead of an error.
+func cachedRates(log *util.Logger, data *util.Monitor[api.Rates]) (api.Rates, error) {
+	var res api.Rates
+	err := data.GetFunc(func(val api.Rates) {
+		res = slices.Clone(val)
+	})
+
+	if errors.Is(err, api.ErrOutdated) && len(res) > 0 {
+		log.WARN.Println("using outdated rates")
+		return res, nil
+	}
+
+	return res, err
+}
@@ -2,7 +2,7 @@ package tariff
 
 import (
 	"errors"
-	"slices"
+	"fmt"
 	"sync"
 	"time"
 
@@ -11,6 +11,7 @@ import (
 	"github.com/evcc-io/evcc/tariff/octopus"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/request"
+	"github.com/jinzhu/now"
 )
 
 type Octopus struct {
@@ -64,10 +65,29 @@ func (t *Octopus) run(done chan error) {
 
 	tick := time.NewTicker(time.Hour)
 	for ; true; <-tick.C {
-		var res octopus.UnitRates
+		from := now.BeginningOfDay()
+		to := from.AddDate(0, 0, 2)
+
+		uri := fmt.Sprintf("%s?period_from=%s&period_to=%s", t.uri,
+			from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+
+		var results []octopus.Rate
 
 		if err := backoff.Retry(func() error {
-			return backoffPermanentError(client.GetJSON(t.uri, &res))
+			results = results[:0]
+
+			// follow pagination, agile tariffs may exceed the page size
+			for next := uri; next != ""; {
+				var res octopus.UnitRates
+				if err := client.GetJSON(next, &res); err != nil {
+					return backoffPermanentError(err)
+				}
+
+				results = append(results, res.Results...)
+				next = res.Next
+			}
+
+			return nil
 		}, bo); err != nil {
 			once.Do(func() { done <- err })
 
@@ -75,30 +95,44 @@ func (t *Octopus) run(done chan error) {
 			continue
 		}
 
-		data := make(api.Rates, 0, len(res.Results))
-		for _, r := range res.Results {
-			ar := api.Rate{
-				Start: r.ValidityStart,
-				End:   r.ValidityEnd,
-				// UnitRates are supplied inclusive of tax, though this could be flipped easily with a config flag.
-				Price: r.PriceInclusiveTax / 1e2,
-			}
-			data = append(data, ar)
+		t.data.Set(octopusRates(results, to))
+		once.Do(func() { close(done) })
+	}
+}
+
+// octopusRates converts unit rates to api.Rates. Agile tariffs publish half-hourly rates, while fixed time-of-use
+// tariffs like Go publish day and night rates which may be open-ended. Open-ended rates last until the next rate
+// starts or until end.
+func octopusRates(results []octopus.Rate, end time.Time) api.Rates {
+	data := make(api.Rates, 0, len(results))
+	for _, r := range results {
+		ar := api.Rate{
+			Start: r.ValidityStart,
+			End:   r.ValidityEnd,
+			// UnitRates are supplied inclusive of tax, though this could be flipped easily with a config flag.
+			Price: r.PriceInclusiveTax / 1e2,
 		}
-		data.Sort()
+		data = append(data, ar)
+	}
+	data.Sort()
 
-		t.data.Set(data)
-		once.Do(func() { close(done) })
+	for i := range data {
+		if !data[i].End.IsZero() {
+			continue
+		}
+
+		data[i].End = end
+		if i+1 < len(data) {
+			data[i].End = data[i+1].Start
+		}
 	}
+
+	return data
 }
 
 // Rates implements the api.Tariff interface
 func (t *Octopus) Rates() (api.Rates, error) {
-	var res api.Rates
-	err := t.data.GetFunc(func(val api.Rates) {
-		res = slices.Clone(val)
-	})
-	return res, err
+	return cachedRates(t.log, t.data)
 }
 
 // Type implements the api.Tariff interface
@@ -0,0 +1,59 @@
+package tariff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/tariff/octopus"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOctopusRates(t *testing.T) {
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := ts.AddDate(0, 0, 1)
+
+	// agile, half-hourly and in descending order
+	agile := octopusRates([]octopus.Rate{
+		{ValidityStart: ts.Add(30 * time.Minute), ValidityEnd: ts.Add(time.Hour), PriceInclusiveTax: 20},
+		{ValidityStart: ts, ValidityEnd: ts.Add(30 * time.Minute), PriceInclusiveTax: 10},
+	}, end)
+
+	assert.Equal(t, api.Rates{
+		{Start: ts, End: ts.Add(30 * time.Minute), Price: 0.1},
+		{Start: ts.Add(30 * time.Minute), End: ts.Add(time.Hour), Price: 0.2},
+	}, agile)
+
+	// go, open-ended day rate
+	goRates := octopusRates([]octopus.Rate{
+		{ValidityStart: ts.Add(4*time.Hour + 30*time.Minute), PriceInclusiveTax: 30},
+		{ValidityStart: ts.Add(30 * time.Minute), ValidityEnd: ts.Add(4*time.Hour + 30*time.Minute), PriceInclusiveTax: 7.5},
+		{ValidityStart: ts, PriceInclusiveTax: 30},
+	}, end)
+
+	assert.Equal(t, api.Rates{
+		{Start: ts, End: ts.Add(30 * time.Minute), Price: 0.3},
+		{Start: ts.Add(30 * time.Minute), End: ts.Add(4*time.Hour + 30*time.Minute), Price: 0.075},
+		{Start: ts.Add(4*time.Hour + 30*time.Minute), End: end, Price: 0.3},
+	}, goRates)
+}
+
+func TestCachedRates(t *testing.T) {
+	log := util.NewLogger("foo")
+	data := util.NewMonitor[api.Rates](time.Nanosecond)
+
+	rates := api.Rates{{Start: time.Now(), End: time.Now().Add(time.Hour), Price: 0.3}}
+	data.Set(rates)
+	time.Sleep(time.Millisecond)
+
+	// outdated rates are served
+	res, err := cachedRates(log, data)
+	require.NoError(t, err)
+	assert.Equal(t, rates, res)
+
+	// no rates
+	_, err = cachedRates(log, util.NewMonitor[api.Rates](0))
+	assert.ErrorIs(t, err, api.ErrOutdated)
+}
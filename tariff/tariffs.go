@@ -10,6 +10,7 @@ import (
 type Tariffs struct {
 	Currency                   currency.Unit
 	Grid, FeedIn, Co2, Planner api.Tariff
+	Co2PerKWh                  float64 // static co2 intensity if no co2 tariff is available
 }
 
 func currentPrice(t api.Tariff) (float64, error) {
@@ -33,10 +34,15 @@ func (t *Tariffs) CurrentFeedInPrice() (float64, error) {
 	return currentPrice(t.FeedIn)
 }
 
-// CurrentCo2 determines the grids co2 emission.
+// CurrentCo2 determines the grids co2 emission. The static co2 intensity is used as fallback.
 func (t *Tariffs) CurrentCo2() (float64, error) {
 	if t.Co2 != nil {
-		return currentPrice(t.Co2)
+		if co2, err := currentPrice(t.Co2); err == nil {
+			return co2, nil
+		}
+	}
+	if t.Co2PerKWh > 0 {
+		return t.Co2PerKWh, nil
 	}
 	return 0, api.ErrNotAvailable
 }
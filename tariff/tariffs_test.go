@@ -0,0 +1,29 @@
+package tariff
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrentCo2Fallback(t *testing.T) {
+	tariffs := Tariffs{}
+
+	_, err := tariffs.CurrentCo2()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	tariffs.Co2PerKWh = 400
+
+	co2, err := tariffs.CurrentCo2()
+	require.NoError(t, err)
+	assert.Equal(t, 400.0, co2)
+
+	tariffs.Co2, err = NewFixedFromConfig(map[string]interface{}{"price": 200})
+	require.NoError(t, err)
+
+	co2, err = tariffs.CurrentCo2()
+	require.NoError(t, err)
+	assert.Equal(t, 200.0, co2)
+}
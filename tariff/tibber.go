@@ -3,7 +3,6 @@ package tariff
 import (
 	"context"
 	"errors"
-	"slices"
 	"sync"
 	"time"
 
@@ -129,11 +128,7 @@ func (t *Tibber) rates(pi []tibber.Price) api.Rates {
 
 // Rates implements the api.Tariff interface
 func (t *Tibber) Rates() (api.Rates, error) {
-	var res api.Rates
-	err := t.data.GetFunc(func(val api.Rates) {
-		res = slices.Clone(val)
-	})
-	return res, err
+	return cachedRates(t.log, t.data)
 }
 
 // Type implements the api.Tariff interface
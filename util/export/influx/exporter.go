@@ -0,0 +1,254 @@
+// Package influx exports charger and meter metrics to InfluxDB 2.x using line protocol without the InfluxDB client.
+package influx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+	"golang.org/x/exp/maps"
+)
+
+// Measurement is the line protocol measurement name
+const Measurement = "evcc"
+
+// Config is the exporter configuration
+type Config struct {
+	URL, Org, Bucket, Token string
+	BatchSize               int
+}
+
+// Exporter collects device metrics as line protocol and writes them in batches
+type Exporter struct {
+	*request.Helper
+	mu        sync.Mutex
+	log       *util.Logger
+	clock     clock.Clock
+	uri       string
+	token     string
+	batchSize int
+	lines     []string
+}
+
+// NewFromConfig creates an exporter from config
+func NewFromConfig(cc Config) (*Exporter, error) {
+	if cc.URL == "" || cc.Bucket == "" {
+		return nil, errors.New("missing url or bucket")
+	}
+
+	return New(util.NewLogger("influx"), cc.URL, cc.Org, cc.Bucket, cc.Token, cc.BatchSize), nil
+}
+
+// New creates an exporter. Lines are written once batchSize lines have been collected.
+func New(log *util.Logger, uri, org, bucket, token string, batchSize int) *Exporter {
+	params := url.Values{
+		"org":       {org},
+		"bucket":    {bucket},
+		"precision": {"s"},
+	}
+
+	return &Exporter{
+		Helper:    request.NewHelper(log),
+		log:       log,
+		clock:     clock.New(),
+		uri:       strings.TrimRight(uri, "/") + "/api/v2/write?" + params.Encode(),
+		token:     token,
+		batchSize: max(batchSize, 1),
+	}
+}
+
+// Export reads the current values of an api.Meter and/or api.Charger and adds them to the batch
+func (e *Exporter) Export(name string, dev any) error {
+	fields := make(map[string]any)
+
+	var errs []error
+	collect := func(err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if c, ok := dev.(api.Charger); ok {
+		status, err := c.Status()
+		if collect(err); err == nil {
+			fields["status"] = string(status)
+		}
+
+		enabled, err := c.Enabled()
+		if collect(err); err == nil {
+			fields["enabled"] = enabled
+		}
+	}
+
+	if m, ok := dev.(api.Meter); ok {
+		power, err := m.CurrentPower()
+		if collect(err); err == nil {
+			fields["power"] = power
+		}
+	}
+
+	if m, ok := dev.(api.MeterEnergy); ok {
+		energy, err := m.TotalEnergy()
+		if collect(err); err == nil {
+			fields["energy"] = energy
+		}
+	}
+
+	for _, phases := range []struct {
+		key string
+		fun func() (float64, float64, float64, error)
+	}{
+		{"current", phaseFunc[api.PhaseCurrents](dev, api.PhaseCurrents.Currents)},
+		{"voltage", phaseFunc[api.PhaseVoltages](dev, api.PhaseVoltages.Voltages)},
+	} {
+		if phases.fun == nil {
+			continue
+		}
+
+		l1, l2, l3, err := phases.fun()
+		if collect(err); err == nil {
+			for i, v := range []float64{l1, l2, l3} {
+				fields[fmt.Sprintf("%sL%d", phases.key, i+1)] = v
+			}
+		}
+	}
+
+	if len(fields) == 0 {
+		if len(errs) == 0 {
+			return fmt.Errorf("%s: no metrics", name)
+		}
+		return fmt.Errorf("%s: %w", name, errors.Join(errs...))
+	}
+
+	for _, err := range errs {
+		e.log.DEBUG.Printf("%s: %v", name, err)
+	}
+
+	return e.add(Line(Measurement, map[string]string{"device": name}, fields, e.clock.Now().Unix()))
+}
+
+// phaseFunc returns the bound phase values function if dev implements T
+func phaseFunc[T any](dev any, fun func(T) (float64, float64, float64, error)) func() (float64, float64, float64, error) {
+	if v, ok := dev.(T); ok {
+		return func() (float64, float64, float64, error) { return fun(v) }
+	}
+	return nil
+}
+
+// add adds a line and flushes the batch when full
+func (e *Exporter) add(line string) error {
+	e.mu.Lock()
+	e.lines = append(e.lines, line)
+	full := len(e.lines) >= e.batchSize
+	e.mu.Unlock()
+
+	if full {
+		return e.Flush()
+	}
+
+	return nil
+}
+
+// Flush writes all collected lines. If the batch write fails, lines are written individually and failing lines are dropped.
+func (e *Exporter) Flush() error {
+	e.mu.Lock()
+	lines := e.lines
+	e.lines = nil
+	e.mu.Unlock()
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	err := e.write(strings.Join(lines, "\n"))
+	if err == nil || len(lines) == 1 {
+		return err
+	}
+
+	e.log.WARN.Printf("batch write: %v, writing %d lines individually", err, len(lines))
+
+	var errs []error
+	for _, line := range lines {
+		if err := e.write(line); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// write posts line protocol data
+func (e *Exporter) write(data string) error {
+	headers := map[string]string{
+		"Content-Type": "text/plain; charset=utf-8",
+	}
+	if e.token != "" {
+		headers["Authorization"] = "Token " + e.token
+	}
+
+	req, err := request.New(http.MethodPost, e.uri, strings.NewReader(data), headers)
+	if err == nil {
+		_, err = e.DoBody(req)
+	}
+
+	return err
+}
+
+var (
+	measurementEscaper = strings.NewReplacer(`,`, `\,`, ` `, `\ `)
+	tagEscaper         = strings.NewReplacer(`,`, `\,`, ` `, `\ `, `=`, `\=`)
+	stringEscaper      = strings.NewReplacer(`"`, `\"`, `\`, `\\`)
+)
+
+// sortedKeys returns the map's keys in sorted order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := maps.Keys(m)
+	slices.Sort(keys)
+	return keys
+}
+
+// Line creates a line protocol string with sorted tags and fields
+func Line(measurement string, tags map[string]string, fields map[string]any, ts int64) string {
+	var b strings.Builder
+	b.WriteString(measurementEscaper.Replace(measurement))
+
+	for _, k := range sortedKeys(tags) {
+		fmt.Fprintf(&b, ",%s=%s", tagEscaper.Replace(k), tagEscaper.Replace(tags[k]))
+	}
+
+	for i, k := range sortedKeys(fields) {
+		sep := ","
+		if i == 0 {
+			sep = " "
+		}
+
+		var v string
+		switch val := fields[k].(type) {
+		case string:
+			v = `"` + stringEscaper.Replace(val) + `"`
+		case bool:
+			v = strconv.FormatBool(val)
+		case int, int64:
+			v = fmt.Sprintf("%di", val)
+		case float64:
+			v = strconv.FormatFloat(val, 'f', -1, 64)
+		default:
+			v = `"` + stringEscaper.Replace(fmt.Sprint(val)) + `"`
+		}
+
+		fmt.Fprintf(&b, "%s%s=%s", sep, tagEscaper.Replace(k), v)
+	}
+
+	fmt.Fprintf(&b, " %d", ts)
+
+	return b.String()
+}
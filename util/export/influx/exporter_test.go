@@ -0,0 +1,83 @@
+package influx
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLine(t *testing.T) {
+	assert.Equal(t,
+		`evcc,device=my\ wallbox enabled=true,power=1234.5,status="C" 1700000000`,
+		Line(Measurement, map[string]string{"device": "my wallbox"}, map[string]any{
+			"status":  "C",
+			"power":   1234.5,
+			"enabled": true,
+		}, 1700000000),
+	)
+}
+
+func TestExporter(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		bodies []string
+		fail   bool
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/write", r.URL.Path)
+		assert.Equal(t, "bucket", r.URL.Query().Get("bucket"))
+		assert.Equal(t, "Token token", r.Header.Get("Authorization"))
+
+		b, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		bodies = append(bodies, string(b))
+		if fail && strings.Contains(string(b), "\n") {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	ctrl := gomock.NewController(t)
+	charger := api.NewMockCharger(ctrl)
+	charger.EXPECT().Status().Return(api.StatusB, nil).AnyTimes()
+	charger.EXPECT().Enabled().Return(false, nil).AnyTimes()
+
+	meter := api.NewMockMeter(ctrl)
+	meter.EXPECT().CurrentPower().Return(100.0, nil).AnyTimes()
+
+	clk := clock.NewMock()
+	e := New(util.NewLogger("foo"), srv.URL, "org", "bucket", "token", 2)
+	e.clock = clk
+
+	// batch is not yet full
+	require.NoError(t, e.Export("charger", charger))
+	assert.Empty(t, bodies)
+
+	require.NoError(t, e.Export("meter", meter))
+	assert.Equal(t, []string{
+		`evcc,device=charger enabled=false,status="B" 0` + "\n" + `evcc,device=meter power=100 0`,
+	}, bodies)
+
+	// batch write fails, lines are written individually
+	bodies = nil
+	fail = true
+
+	require.NoError(t, e.Export("charger", charger))
+	require.NoError(t, e.Export("meter", meter))
+	assert.Len(t, bodies, 3)
+	assert.Equal(t, []string{`evcc,device=charger enabled=false,status="B" 0`, `evcc,device=meter power=100 0`}, bodies[1:])
+}
@@ -0,0 +1,109 @@
+package modbus
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// CachedError is returned together with the last successfully read value if a read fails
+// but the cached value is younger than the stale data timeout
+type CachedError struct {
+	Err error
+	Age time.Duration
+}
+
+func (e *CachedError) Error() string {
+	return fmt.Sprintf("%v (using cached value from %v ago)", e.Err, e.Age.Round(time.Millisecond))
+}
+
+func (e *CachedError) Unwrap() error {
+	return e.Err
+}
+
+// IgnoreCached returns cached read results without error for callers that don't distinguish stale data
+func IgnoreCached(res []byte, err error) ([]byte, error) {
+	if ce := new(CachedError); errors.As(err, &ce) {
+		return res, nil
+	}
+	return res, err
+}
+
+type cacheKey struct {
+	slaveID           uint8
+	functionCode      byte
+	address, quantity uint16
+}
+
+type cacheEntry struct {
+	res     []byte
+	updated time.Time
+}
+
+// readCache holds the last successful read results
+type readCache struct {
+	mu      sync.Mutex
+	timeout time.Duration
+	entries map[cacheKey]cacheEntry
+}
+
+func newReadCache() *readCache {
+	return &readCache{
+		entries: make(map[cacheKey]cacheEntry),
+	}
+}
+
+// StaleDataTimeout sets the maximum age of cached read results served on failed reads, caching is disabled by default
+func (mb *Connection) StaleDataTimeout(timeout time.Duration) {
+	mb.cache.mu.Lock()
+	defer mb.cache.mu.Unlock()
+	mb.cache.timeout = timeout
+}
+
+// read executes a read operation, falling back to the cached result if it fails without device response.
+// Cached results are returned with a CachedError wrapping the read error.
+func (mb *Connection) read(slaveID, functionCode byte, address, quantity uint16, op func(modbus.Client) ([]byte, error)) ([]byte, error) {
+	res, err := mb.exec(slaveID, address, op)
+
+	// guard decoders against malformed responses
+	if err == nil {
+		if err = CheckRegisterResponse(res, responseBytes(functionCode, quantity)); err != nil {
+			res = nil
+		}
+	}
+
+	c := mb.cache
+	key := cacheKey{slaveID, functionCode, address, quantity}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil {
+		if c.timeout > 0 {
+			c.entries[key] = cacheEntry{res: append([]byte{}, res...), updated: time.Now()}
+		}
+		return res, nil
+	}
+
+	// device responded, cached value is outdated
+	if ee := new(ExceptionError); errors.As(err, &ee) {
+		delete(c.entries, key)
+		return res, err
+	}
+
+	if e, ok := c.entries[key]; ok {
+		if age := time.Since(e.updated); age < c.timeout {
+			cerr := &CachedError{Err: err, Age: age}
+			if mb.logger != nil {
+				mb.logger.Printf("slave %d: %v", slaveID, cerr)
+			}
+			return append([]byte{}, e.res...), cerr
+		}
+		delete(c.entries, key)
+	}
+
+	return res, err
+}
@@ -0,0 +1,70 @@
+package modbus
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util/modbus/simulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCache(t *testing.T) {
+	sim, err := simulator.NewRTUSimulator(map[uint16]uint16{1: 0x1234})
+	require.NoError(t, err)
+
+	conn, err := NewConnection(sim.Addr(), "", "", 0, Rtu, 1)
+	require.NoError(t, err)
+	conn.Timeout(100 * time.Millisecond)
+	conn.StaleDataTimeout(10 * time.Second)
+
+	b, err := conn.ReadHoldingRegisters(1, 1)
+	require.NoError(t, err)
+
+	// exceptions are not served from cache
+	_, err = conn.ReadHoldingRegisters(2, 1)
+	var ee *ExceptionError
+	require.ErrorAs(t, err, &ee)
+
+	// disconnect device
+	require.NoError(t, sim.Close())
+	conn.conn.Close()
+
+	res, err := conn.ReadHoldingRegisters(1, 1)
+	var ce *CachedError
+	require.ErrorAs(t, err, &ce)
+	assert.Equal(t, b, res)
+	assert.NotNil(t, errors.Unwrap(err))
+
+	res, err = IgnoreCached(conn.ReadHoldingRegisters(1, 1))
+	require.NoError(t, err)
+	assert.Equal(t, b, res)
+
+	// stale value is not served
+	conn.StaleDataTimeout(time.Nanosecond)
+	_, err = conn.ReadHoldingRegisters(1, 1)
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &ce))
+}
+
+func TestReadCacheDisabled(t *testing.T) {
+	sim, err := simulator.NewRTUSimulator(map[uint16]uint16{1: 0x1234})
+	require.NoError(t, err)
+
+	conn, err := NewConnection(sim.Addr(), "", "", 0, Rtu, 1)
+	require.NoError(t, err)
+	conn.Timeout(100 * time.Millisecond)
+
+	_, err = conn.ReadHoldingRegisters(1, 1)
+	require.NoError(t, err)
+
+	// not cached by default
+	require.NoError(t, sim.Close())
+	conn.conn.Close()
+
+	_, err = conn.ReadHoldingRegisters(1, 1)
+	require.Error(t, err)
+	var ce *CachedError
+	assert.False(t, errors.As(err, &ce))
+}
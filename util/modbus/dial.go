@@ -0,0 +1,132 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const dialTimeout = 10 * time.Second
+
+// dialConnection implements meters.Connection for Modbus TCP framing over a custom dialed connection, e.g. TLS
+type dialConnection struct {
+	address      string
+	dial         func(timeout time.Duration) (net.Conn, error)
+	packager     *modbus.TCPClientHandler // used for framing only
+	client       modbus.Client
+	logger       meters.Logger
+	timeout      time.Duration
+	connectDelay time.Duration
+	conn         net.Conn
+}
+
+var _ meters.Connection = (*dialConnection)(nil)
+
+func newDialConnection(address string, dial func(timeout time.Duration) (net.Conn, error)) *dialConnection {
+	c := &dialConnection{
+		address:  address,
+		dial:     dial,
+		packager: modbus.NewTCPClientHandler(address),
+		timeout:  dialTimeout,
+	}
+
+	c.client = modbus.NewClient2(c.packager, c)
+
+	return c
+}
+
+// Send implements the modbus.Transporter interface
+func (c *dialConnection) Send(adu []byte) ([]byte, error) {
+	if c.conn == nil {
+		conn, err := c.dial(c.timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		c.conn = conn
+
+		if c.connectDelay > 0 {
+			time.Sleep(c.connectDelay)
+		}
+	}
+
+	if err := c.conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return nil, err
+	}
+
+	c.logf("modbus: send % x", adu)
+	if _, err := c.conn.Write(adu); err != nil {
+		return nil, err
+	}
+
+	// mbap header: transaction id, protocol id, length, unit id
+	res := make([]byte, 7)
+	if _, err := io.ReadFull(c.conn, res); err != nil {
+		return nil, err
+	}
+
+	length := int(binary.BigEndian.Uint16(res[4:]))
+	if length < 2 || length > 254 {
+		return nil, fmt.Errorf("invalid mbap length: %d", length)
+	}
+
+	res = append(res, make([]byte, length-1)...)
+	if _, err := io.ReadFull(c.conn, res[7:]); err != nil {
+		return nil, err
+	}
+
+	c.logf("modbus: recv % x", res)
+
+	return res, nil
+}
+
+func (c *dialConnection) logf(format string, v ...any) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+	}
+}
+
+// String implements the meters.Connection interface
+func (c *dialConnection) String() string {
+	return c.address
+}
+
+// ModbusClient implements the meters.Connection interface
+func (c *dialConnection) ModbusClient() modbus.Client {
+	return c.client
+}
+
+// Logger implements the meters.Connection interface
+func (c *dialConnection) Logger(l meters.Logger) {
+	c.logger = l
+}
+
+// Slave implements the meters.Connection interface
+func (c *dialConnection) Slave(deviceID uint8) {
+	c.packager.SetSlave(deviceID)
+}
+
+// Timeout implements the meters.Connection interface
+func (c *dialConnection) Timeout(timeout time.Duration) time.Duration {
+	t := c.timeout
+	c.timeout = timeout
+	return t
+}
+
+// ConnectDelay implements the meters.Connection interface
+func (c *dialConnection) ConnectDelay(delay time.Duration) {
+	c.connectDelay = delay
+}
+
+// Close implements the meters.Connection interface
+func (c *dialConnection) Close() {
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+}
@@ -0,0 +1,54 @@
+package modbus
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grid-x/modbus"
+)
+
+// ExceptionError is an exception response returned by the modbus device
+type ExceptionError struct {
+	FunctionCode  byte
+	ExceptionCode byte
+	Address       uint16
+}
+
+var exceptionNames = map[byte]string{
+	modbus.ExceptionCodeIllegalFunction:                    "Illegal Function",
+	modbus.ExceptionCodeIllegalDataAddress:                 "Illegal Data Address",
+	modbus.ExceptionCodeIllegalDataValue:                   "Illegal Data Value",
+	modbus.ExceptionCodeServerDeviceFailure:                "Slave Device Failure",
+	modbus.ExceptionCodeAcknowledge:                        "Acknowledge",
+	modbus.ExceptionCodeServerDeviceBusy:                   "Slave Device Busy",
+	modbus.ExceptionCodeMemoryParityError:                  "Memory Parity Error",
+	modbus.ExceptionCodeGatewayPathUnavailable:             "Gateway Path Unavailable",
+	modbus.ExceptionCodeGatewayTargetDeviceFailedToRespond: "Gateway Target Device Failed To Respond",
+}
+
+func (e *ExceptionError) Error() string {
+	name, ok := exceptionNames[e.ExceptionCode]
+	if !ok {
+		name = fmt.Sprintf("Exception %d", e.ExceptionCode)
+	}
+	return fmt.Sprintf("%s (function %d, register %d)", name, e.FunctionCode&0x7F, e.Address)
+}
+
+// isException checks if err is a modbus exception response
+func isException(err error) bool {
+	var me *modbus.Error
+	return errors.As(err, &me)
+}
+
+// exceptionError converts modbus exception responses to ExceptionError
+func exceptionError(err error, address uint16) error {
+	var me *modbus.Error
+	if errors.As(err, &me) {
+		return &ExceptionError{
+			FunctionCode:  me.FunctionCode,
+			ExceptionCode: me.ExceptionCode,
+			Address:       address,
+		}
+	}
+	return err
+}
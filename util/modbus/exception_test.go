@@ -0,0 +1,19 @@
+package modbus
+
+import (
+	"io"
+	"testing"
+
+	"github.com/grid-x/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExceptionError(t *testing.T) {
+	err := exceptionError(&modbus.Error{FunctionCode: 0x84, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}, 21316)
+
+	var ee *ExceptionError
+	require.ErrorAs(t, err, &ee)
+	require.Equal(t, "Illegal Data Address (function 4, register 21316)", err.Error())
+
+	require.Equal(t, io.EOF, exceptionError(io.EOF, 0))
+}
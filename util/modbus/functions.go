@@ -96,3 +96,13 @@ func decodeNaN64(f func(b []byte) float64, nan ...uint64) func(b []byte) float64
 		return f(b)
 	}
 }
+
+// RTUInt16ToFloat64 converts a big-endian signed 16-bit register value to float64
+func RTUInt16ToFloat64(b []byte) float64 {
+	return float64(int16(binary.BigEndian.Uint16(b)))
+}
+
+// RTUInt32ToFloat64Swapped converts a signed 32-bit value with the low word first to float64
+func RTUInt32ToFloat64Swapped(b []byte) float64 {
+	return float64(int32(uint32(binary.BigEndian.Uint16(b[2:]))<<16 | uint32(binary.BigEndian.Uint16(b))))
+}
@@ -0,0 +1,18 @@
+package modbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignedConversion(t *testing.T) {
+	assert.Equal(t, 1.0, RTUInt16ToFloat64([]byte{0x00, 0x01}))
+	assert.Equal(t, -1.0, RTUInt16ToFloat64([]byte{0xff, 0xff}))
+	assert.Equal(t, -32768.0, RTUInt16ToFloat64([]byte{0x80, 0x00}))
+
+	// low word first
+	assert.Equal(t, 65536.0, RTUInt32ToFloat64Swapped([]byte{0x00, 0x00, 0x00, 0x01}))
+	assert.Equal(t, -2.0, RTUInt32ToFloat64Swapped([]byte{0xff, 0xfe, 0xff, 0xff}))
+	assert.Equal(t, -65536.0, RTUInt32ToFloat64Swapped([]byte{0x00, 0x00, 0xff, 0xff}))
+}
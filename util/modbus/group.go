@@ -0,0 +1,84 @@
+package modbus
+
+import (
+	"slices"
+)
+
+// maxReadQuantity is the maximum number of registers per read request
+const maxReadQuantity = 125
+
+// Range is a block of contiguous registers
+type Range struct {
+	Address, Count uint16
+}
+
+func (r Range) end() uint16 {
+	return r.Address + r.Count
+}
+
+// RegisterGroup reads multiple register ranges using the minimal number of read requests.
+// Ranges within maxGap registers of each other are merged into a single request.
+type RegisterGroup struct {
+	read     func(address, quantity uint16) ([]byte, error)
+	ranges   []Range
+	requests []Range
+}
+
+// NewRegisterGroup creates a register group using the read function, e.g. Connection.ReadInputRegisters
+func NewRegisterGroup(read func(address, quantity uint16) ([]byte, error), maxGap uint16, ranges ...Range) *RegisterGroup {
+	g := &RegisterGroup{
+		read:   read,
+		ranges: ranges,
+	}
+
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b Range) int {
+		return int(a.Address) - int(b.Address)
+	})
+
+	for _, r := range sorted {
+		if n := len(g.requests); n > 0 {
+			req := &g.requests[n-1]
+			end := max(req.end(), r.end())
+
+			if int(r.Address) <= int(req.end())+int(maxGap) && end-req.Address <= maxReadQuantity {
+				req.Count = end - req.Address
+				continue
+			}
+		}
+
+		g.requests = append(g.requests, r)
+	}
+
+	return g
+}
+
+// Requests returns the number of read requests
+func (g *RegisterGroup) Requests() int {
+	return len(g.requests)
+}
+
+// Read reads all ranges and returns the results by range address
+func (g *RegisterGroup) Read() (map[uint16][]byte, error) {
+	res := make(map[uint16][]byte, len(g.ranges))
+
+	for _, req := range g.requests {
+		b, err := g.read(req.Address, req.Count)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := CheckRegisterResponse(b, 2*int(req.Count)); err != nil {
+			return nil, err
+		}
+
+		for _, r := range g.ranges {
+			if r.Address >= req.Address && r.end() <= req.end() {
+				offset := 2 * (r.Address - req.Address)
+				res[r.Address] = b[offset : offset+2*r.Count]
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,49 @@
+package modbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterGroup(t *testing.T) {
+	var requests []Range
+
+	// register value equals its address
+	read := func(address, quantity uint16) ([]byte, error) {
+		requests = append(requests, Range{address, quantity})
+
+		b := make([]byte, 2*quantity)
+		for i := range quantity {
+			b[2*i], b[2*i+1] = byte((address+i)>>8), byte(address+i)
+		}
+		return b, nil
+	}
+
+	for _, tc := range []struct {
+		maxGap   uint16
+		ranges   []Range
+		requests []Range
+	}{
+		{0, []Range{{21302, 1}, {21304, 1}, {21306, 1}}, []Range{{21302, 1}, {21304, 1}, {21306, 1}}},
+		{1, []Range{{21306, 1}, {21302, 1}, {21304, 1}}, []Range{{21302, 5}}},
+		{0, []Range{{100, 2}, {102, 2}, {101, 2}}, []Range{{100, 4}}},
+		{10, []Range{{0, 100}, {105, 30}}, []Range{{0, 100}, {105, 30}}}, // max request size
+	} {
+		requests = nil
+
+		g := NewRegisterGroup(read, tc.maxGap, tc.ranges...)
+		res, err := g.Read()
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.requests, requests, tc)
+		assert.Equal(t, len(tc.requests), g.Requests())
+
+		for _, r := range tc.ranges {
+			b := res[r.Address]
+			require.Len(t, b, 2*int(r.Count))
+			assert.Equal(t, r.Address, uint16(b[0])<<8|uint16(b[1]))
+		}
+	}
+}
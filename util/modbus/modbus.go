@@ -1,16 +1,21 @@
 package modbus
 
 import (
+	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/evcc-io/evcc/util"
+	"github.com/grid-x/modbus"
 	"github.com/volkszaehler/mbmd/meters"
 	"github.com/volkszaehler/mbmd/meters/rs485"
 	"github.com/volkszaehler/mbmd/meters/sunspec"
+	"golang.org/x/time/rate"
 )
 
 type Protocol int
@@ -23,6 +28,21 @@ const (
 	CoilOn uint16 = 0xFF00
 )
 
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+func (p *Protocol) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "tcp":
+		*p = Tcp
+	case "rtu":
+		*p = Rtu
+	case "ascii":
+		*p = Ascii
+	default:
+		return fmt.Errorf("invalid protocol: %s", text)
+	}
+	return nil
+}
+
 // Settings contains the ModBus TCP settings
 // RTU field is included for compatibility with modbus.tpl which renders rtu: false for TCP
 // TODO remove RTU field (https://github.com/evcc-io/evcc/issues/3360)
@@ -39,6 +59,16 @@ type Settings struct {
 	URI, Device, Comset string
 	Baudrate            int
 	RTU                 *bool // indicates RTU over TCP if true
+
+	Proto *Protocol // wire format, takes precedence over RTU
+}
+
+// Protocol returns the configured wire format
+func (s *Settings) Protocol() Protocol {
+	if s.Proto != nil {
+		return *s.Proto
+	}
+	return ProtocolFromRTU(s.RTU)
 }
 
 func (s *Settings) String() string {
@@ -50,10 +80,29 @@ func (s *Settings) String() string {
 
 // Connection decorates a meters.Connection with transparent slave id and error handling
 type Connection struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
 	slaveID uint8
-	mu      sync.Mutex
+	mu      *sync.Mutex // shared by all connections to the same bus
 	conn    meters.Connection
+	release func() // releases the shared physical connection
+	closed  sync.Once
 	delay   time.Duration
+	healthy atomic.Bool
+	cache   *readCache
+	logger  meters.Logger
+
+	noReadWrite atomic.Bool // device does not support function code 23
+
+	// write rate limit per register address
+	writeRateLimit time.Duration
+	limitersMu     sync.Mutex
+	limiters       map[uint16]*rate.Limiter
+
+	// wakeup
+	wakeup       func() error
+	wakeAttempts int
+	waking       atomic.Bool
 }
 
 func (mb *Connection) prepare(slaveID uint8) {
@@ -64,17 +113,120 @@ func (mb *Connection) prepare(slaveID uint8) {
 }
 
 func (mb *Connection) handle(res []byte, err error) ([]byte, error) {
-	if err != nil {
+	// keep connection open if device responded with exception
+	if err != nil && !isException(err) {
 		mb.conn.Close()
 	}
+	mb.healthy.Store(err == nil)
 	return res, err
 }
 
+// do executes a single modbus operation for the given slave id
+func (mb *Connection) do(slaveID uint8, op func(modbus.Client) ([]byte, error)) ([]byte, error) {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	mb.prepare(slaveID)
+	return mb.handle(op(mb.conn.ModbusClient()))
+}
+
+// wake calls the wakeup function and returns false if the device is already being woken up
+func (mb *Connection) wake() bool {
+	// prevent recursion if wakeup uses the connection itself
+	if !mb.waking.CompareAndSwap(false, true) {
+		return false
+	}
+	defer mb.waking.Store(false)
+
+	// sleeping devices may not answer the wakeup itself
+	_ = mb.wakeup()
+
+	return true
+}
+
+// exec executes a modbus operation, waking up the device before the operation if the connection is unhealthy
+// and retrying the operation after each wakeup attempt if it fails
+func (mb *Connection) exec(slaveID uint8, address uint16, op func(modbus.Client) ([]byte, error)) ([]byte, error) {
+	if mb.wakeup != nil && !mb.Healthy() {
+		mb.wake()
+	}
+
+	res, err := mb.do(slaveID, op)
+
+	// device is awake if it responds with an exception
+	for i := 0; err != nil && !isException(err) && mb.wakeup != nil && i < mb.wakeAttempts; i++ {
+		if !mb.wake() {
+			break
+		}
+
+		res, err = mb.do(slaveID, op)
+	}
+
+	return res, exceptionError(err, address)
+}
+
+// Context returns the connection's context which is cancelled on Close
+func (mb *Connection) Context() context.Context {
+	return mb.ctx
+}
+
+// Close implements the io.Closer interface. It cancels the connection's context, stopping background operations.
+// The shared physical connection is closed together with its last slave connection.
+func (mb *Connection) Close() error {
+	mb.cancel()
+	mb.closed.Do(mb.release)
+	return nil
+}
+
+// Healthy returns false if the last modbus operation failed
+func (mb *Connection) Healthy() bool {
+	return mb.healthy.Load()
+}
+
+// Wakeup sets a wakeup function that is called before retrying failed operations up to the given number of attempts
+func (mb *Connection) Wakeup(wakeup func() error, attempts int) {
+	mb.wakeup = wakeup
+	mb.wakeAttempts = attempts
+}
+
 // Delay sets delay so use between subsequent modbus operations
 func (mb *Connection) Delay(delay time.Duration) {
 	mb.delay = delay
 }
 
+// WriteRateLimit sets the minimum interval between subsequent writes to the same register address
+func (mb *Connection) WriteRateLimit(interval time.Duration) {
+	mb.limitersMu.Lock()
+	defer mb.limitersMu.Unlock()
+	mb.writeRateLimit = interval
+	mb.limiters = make(map[uint16]*rate.Limiter)
+}
+
+// waitWrite blocks until writing the register address is allowed by the write rate limit
+func (mb *Connection) waitWrite(address uint16) error {
+	mb.limitersMu.Lock()
+	if mb.writeRateLimit <= 0 {
+		mb.limitersMu.Unlock()
+		return nil
+	}
+
+	limiter, ok := mb.limiters[address]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Every(mb.writeRateLimit), 1)
+		mb.limiters[address] = limiter
+	}
+	mb.limitersMu.Unlock()
+
+	return limiter.Wait(mb.ctx)
+}
+
+// write executes a write operation, honoring the write rate limit of the register address
+func (mb *Connection) write(slaveID uint8, address uint16, op func(modbus.Client) ([]byte, error)) ([]byte, error) {
+	if err := mb.waitWrite(address); err != nil {
+		return nil, err
+	}
+	return mb.exec(slaveID, address, op)
+}
+
 // ConnectDelay sets the initial delay after connecting before starting communication
 func (mb *Connection) ConnectDelay(delay time.Duration) {
 	mb.conn.ConnectDelay(delay)
@@ -82,6 +234,7 @@ func (mb *Connection) ConnectDelay(delay time.Duration) {
 
 // Logger sets logger implementation
 func (mb *Connection) Logger(logger meters.Logger) {
+	mb.logger = logger
 	mb.conn.Logger(logger)
 }
 
@@ -92,90 +245,82 @@ func (mb *Connection) Timeout(timeout time.Duration) {
 
 // ReadCoils wraps the underlying implementation
 func (mb *Connection) ReadCoilsWithSlave(slaveID uint8, address, quantity uint16) ([]byte, error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadCoils(address, quantity))
+	return mb.read(slaveID, modbus.FuncCodeReadCoils, address, quantity, func(client modbus.Client) ([]byte, error) {
+		return client.ReadCoils(address, quantity)
+	})
 }
 
 // WriteSingleCoil wraps the underlying implementation
 func (mb *Connection) WriteSingleCoilWithSlave(slaveID uint8, address, value uint16) ([]byte, error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().WriteSingleCoil(address, value))
+	return mb.write(slaveID, address, func(client modbus.Client) ([]byte, error) {
+		return client.WriteSingleCoil(address, value)
+	})
 }
 
 // ReadInputRegisters wraps the underlying implementation
 func (mb *Connection) ReadInputRegistersWithSlave(slaveID uint8, address, quantity uint16) ([]byte, error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadInputRegisters(address, quantity))
+	return mb.read(slaveID, modbus.FuncCodeReadInputRegisters, address, quantity, func(client modbus.Client) ([]byte, error) {
+		return client.ReadInputRegisters(address, quantity)
+	})
 }
 
 // ReadHoldingRegisters wraps the underlying implementation
 func (mb *Connection) ReadHoldingRegistersWithSlave(slaveID uint8, address, quantity uint16) ([]byte, error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadHoldingRegisters(address, quantity))
+	return mb.read(slaveID, modbus.FuncCodeReadHoldingRegisters, address, quantity, func(client modbus.Client) ([]byte, error) {
+		return client.ReadHoldingRegisters(address, quantity)
+	})
 }
 
 // WriteSingleRegister wraps the underlying implementation
 func (mb *Connection) WriteSingleRegisterWithSlave(slaveID uint8, address, value uint16) ([]byte, error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().WriteSingleRegister(address, value))
+	return mb.write(slaveID, address, func(client modbus.Client) ([]byte, error) {
+		return client.WriteSingleRegister(address, value)
+	})
 }
 
 // WriteMultipleRegisters wraps the underlying implementation
 func (mb *Connection) WriteMultipleRegistersWithSlave(slaveID uint8, address, quantity uint16, value []byte) ([]byte, error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().WriteMultipleRegisters(address, quantity, value))
+	return mb.write(slaveID, address, func(client modbus.Client) ([]byte, error) {
+		return client.WriteMultipleRegisters(address, quantity, value)
+	})
 }
 
 // ReadDiscreteInputs wraps the underlying implementation
 func (mb *Connection) ReadDiscreteInputsWithSlave(slaveID uint8, address, quantity uint16) (results []byte, err error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadDiscreteInputs(address, quantity))
+	return mb.read(slaveID, modbus.FuncCodeReadDiscreteInputs, address, quantity, func(client modbus.Client) ([]byte, error) {
+		return client.ReadDiscreteInputs(address, quantity)
+	})
 }
 
 // WriteMultipleCoils wraps the underlying implementation
 func (mb *Connection) WriteMultipleCoilsWithSlave(slaveID uint8, address, quantity uint16, value []byte) (results []byte, err error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().WriteMultipleCoils(address, quantity, value))
+	return mb.write(slaveID, address, func(client modbus.Client) ([]byte, error) {
+		return client.WriteMultipleCoils(address, quantity, value)
+	})
 }
 
 // ReadWriteMultipleRegisters wraps the underlying implementation
 func (mb *Connection) ReadWriteMultipleRegistersWithSlave(slaveID uint8, readAddress, readQuantity, writeAddress, writeQuantity uint16, value []byte) (results []byte, err error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity, value))
+	if err := mb.waitWrite(writeAddress); err != nil {
+		return nil, err
+	}
+	return mb.exec(slaveID, readAddress, func(client modbus.Client) ([]byte, error) {
+		return client.ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity, value)
+	})
 }
 
 // MaskWriteRegister wraps the underlying implementation
 func (mb *Connection) MaskWriteRegisterWithSlave(slaveID uint8, address, andMask, orMask uint16) (results []byte, err error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().MaskWriteRegister(address, andMask, orMask))
+	return mb.write(slaveID, address, func(client modbus.Client) ([]byte, error) {
+		return client.MaskWriteRegister(address, andMask, orMask)
+	})
 }
 
 // ReadFIFOQueue wraps the underlying implementation
 func (mb *Connection) ReadFIFOQueueWithSlave(slaveID uint8, address uint16) (results []byte, err error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadFIFOQueue(address))
+	return mb.exec(slaveID, address, func(client modbus.Client) ([]byte, error) {
+		return client.ReadFIFOQueue(address)
+	})
 }
 
 func (mb *Connection) ReadCoils(address, quantity uint16) ([]byte, error) {
@@ -186,6 +331,17 @@ func (mb *Connection) WriteSingleCoil(address, value uint16) ([]byte, error) {
 	return mb.WriteSingleCoilWithSlave(mb.slaveID, address, value)
 }
 
+// WriteCoil switches a single coil on or off
+func (mb *Connection) WriteCoil(address uint16, value bool) error {
+	var u uint16
+	if value {
+		u = CoilOn
+	}
+
+	_, err := mb.WriteSingleCoil(address, u)
+	return err
+}
+
 func (mb *Connection) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
 	return mb.ReadInputRegistersWithSlave(mb.slaveID, address, quantity)
 }
@@ -214,6 +370,38 @@ func (mb *Connection) ReadWriteMultipleRegisters(readAddress, readQuantity, writ
 	return mb.ReadWriteMultipleRegistersWithSlave(mb.slaveID, readAddress, readQuantity, writeAddress, writeQuantity, value)
 }
 
+// WriteReadRegisters writes and reads holding registers in a single transaction (function code 23).
+// Devices not supporting the function code are accessed using separate write and read operations.
+func (mb *Connection) WriteReadRegisters(writeAddr, readAddr uint16, writeData []byte, readCount uint16) ([]byte, error) {
+	writeCount := uint16(len(writeData) / 2)
+
+	if !mb.noReadWrite.Load() {
+		res, err := mb.ReadWriteMultipleRegisters(readAddr, readCount, writeAddr, writeCount, writeData)
+		if err == nil {
+			if err := CheckRegisterResponse(res, 2*int(readCount)); err != nil {
+				return nil, err
+			}
+		}
+		if ee := new(ExceptionError); !errors.As(err, &ee) || ee.ExceptionCode != modbus.ExceptionCodeIllegalFunction {
+			return res, err
+		}
+
+		mb.noReadWrite.Store(true)
+	}
+
+	var err error
+	if writeCount == 1 {
+		_, err = mb.WriteSingleRegister(writeAddr, binary.BigEndian.Uint16(writeData))
+	} else {
+		_, err = mb.WriteMultipleRegisters(writeAddr, writeCount, writeData)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mb.ReadHoldingRegisters(readAddr, readCount)
+}
+
 func (mb *Connection) MaskWriteRegister(address, andMask, orMask uint16) (results []byte, err error) {
 	return mb.MaskWriteRegisterWithSlave(mb.slaveID, address, andMask, orMask)
 }
@@ -222,22 +410,55 @@ func (mb *Connection) ReadFIFOQueue(address uint16) (results []byte, err error)
 	return mb.ReadFIFOQueueWithSlave(mb.slaveID, address)
 }
 
+// busConnection is a physical connection and the lock serializing its access
+type busConnection struct {
+	conn meters.Connection
+	mu   *sync.Mutex
+	refs int
+}
+
 var (
-	connections = make(map[string]meters.Connection)
+	connections = make(map[string]busConnection)
 	mu          sync.Mutex
 )
 
-func registeredConnection(key string, newConn meters.Connection) meters.Connection {
+// registeredConnection returns the physical connection for the given key, its bus lock and a function releasing it.
+// Sharing the lock ensures that e.g. the modbus proxy and evcc's own devices never interleave requests on the same bus.
+func registeredConnection(key string, newConn meters.Connection) (meters.Connection, *sync.Mutex, func()) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	bc, ok := connections[key]
+	if !ok {
+		bc = busConnection{conn: newConn, mu: new(sync.Mutex)}
+	}
+
+	bc.refs++
+	connections[key] = bc
+
+	return bc.conn, bc.mu, func() { releaseConnection(key) }
+}
+
+// releaseConnection closes the physical connection once it is no longer used
+func releaseConnection(key string) {
 	mu.Lock()
 	defer mu.Unlock()
 
-	if conn, ok := connections[key]; ok {
-		return conn
+	bc, ok := connections[key]
+	if !ok {
+		return
 	}
 
-	connections[key] = newConn
+	if bc.refs--; bc.refs > 0 {
+		connections[key] = bc
+		return
+	}
+
+	delete(connections, key)
 
-	return newConn
+	bc.mu.Lock()
+	bc.conn.Close()
+	bc.mu.Unlock()
 }
 
 // ProtocolFromRTU identifies the wire format from the RTU setting
@@ -250,7 +471,11 @@ func ProtocolFromRTU(rtu *bool) Protocol {
 
 // NewConnection creates physical modbus device from config
 func NewConnection(uri, device, comset string, baudrate int, proto Protocol, slaveID uint8) (*Connection, error) {
-	var conn meters.Connection
+	var (
+		conn    meters.Connection
+		connMu  *sync.Mutex
+		release func()
+	)
 
 	if device != "" && uri != "" {
 		return nil, errors.New("invalid modbus configuration: can only have either uri or device")
@@ -270,22 +495,36 @@ func NewConnection(uri, device, comset string, baudrate int, proto Protocol, sla
 		}
 
 		if proto == Ascii {
-			conn = registeredConnection(device, meters.NewASCII(device, baudrate, comset))
+			conn, connMu, release = registeredConnection(device, meters.NewASCII(device, baudrate, comset))
 		} else {
-			conn = registeredConnection(device, meters.NewRTU(device, baudrate, comset))
+			conn, connMu, release = registeredConnection(device, meters.NewRTU(device, baudrate, comset))
 		}
 	}
 
+	if strings.HasPrefix(uri, socksScheme) {
+		if proto != Tcp {
+			return nil, errors.New("invalid modbus configuration: proxy requires tcp protocol")
+		}
+		return NewSocksConnection(uri, slaveID)
+	}
+
+	if strings.HasPrefix(uri, tlsScheme) {
+		if proto != Tcp {
+			return nil, errors.New("invalid modbus configuration: tls requires tcp protocol")
+		}
+		return NewTLSConnection(uri, slaveID, TLSSettings{})
+	}
+
 	if uri != "" {
-		uri = util.DefaultPort(uri, 502)
+		uri = util.DefaultPort(strings.TrimPrefix(uri, tcpScheme), 502)
 
 		switch proto {
 		case Rtu:
-			conn = registeredConnection(uri, meters.NewRTUOverTCP(uri))
+			conn, connMu, release = registeredConnection(uri, meters.NewRTUOverTCP(uri))
 		case Ascii:
-			conn = registeredConnection(uri, meters.NewASCIIOverTCP(uri))
+			conn, connMu, release = registeredConnection(uri, meters.NewASCIIOverTCP(uri))
 		default:
-			conn = registeredConnection(uri, meters.NewTCP(uri))
+			conn, connMu, release = registeredConnection(uri, meters.NewTCP(uri))
 		}
 	}
 
@@ -293,12 +532,25 @@ func NewConnection(uri, device, comset string, baudrate int, proto Protocol, sla
 		return nil, errors.New("invalid modbus configuration: need either uri or device")
 	}
 
+	return newConnection(conn, connMu, release, slaveID), nil
+}
+
+// newConnection creates a slave connection on the physical connection
+func newConnection(conn meters.Connection, connMu *sync.Mutex, release func(), slaveID uint8) *Connection {
+	ctx, cancel := context.WithCancel(context.Background())
+
 	slaveConn := &Connection{
+		ctx:     ctx,
+		cancel:  cancel,
 		slaveID: slaveID,
+		mu:      connMu,
 		conn:    conn,
+		release: release,
+		cache:   newReadCache(),
 	}
+	slaveConn.healthy.Store(true)
 
-	return slaveConn, nil
+	return slaveConn
 }
 
 // NewDevice creates physical modbus device from config
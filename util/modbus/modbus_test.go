@@ -1,8 +1,12 @@
 package modbus
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/modbus/simulator"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,3 +27,145 @@ func TestParsePoint(t *testing.T) {
 		require.Equal(t, tc.ops, ops)
 	}
 }
+
+func TestSettingsProtocol(t *testing.T) {
+	tc := []struct {
+		in    map[string]any
+		proto Protocol
+	}{
+		{map[string]any{}, Tcp},
+		{map[string]any{"rtu": true}, Rtu},
+		{map[string]any{"proto": "ascii"}, Ascii},
+		{map[string]any{"proto": "TCP", "rtu": true}, Tcp},
+	}
+
+	for _, tc := range tc {
+		t.Log(tc)
+
+		var cc Settings
+		require.NoError(t, util.DecodeOther(tc.in, &cc))
+		require.Equal(t, tc.proto, cc.Protocol())
+	}
+
+	var cc Settings
+	require.Error(t, util.DecodeOther(map[string]any{"proto": "foo"}, &cc))
+}
+
+func TestClosedConnection(t *testing.T) {
+	sim, err := simulator.NewRTUSimulator(map[uint16]uint16{1: 0x1234})
+	require.NoError(t, err)
+	defer sim.Close()
+
+	conn, err := NewConnection(sim.Addr(), "", "", 0, Rtu, 1)
+	require.NoError(t, err)
+	conn.Timeout(100 * time.Millisecond)
+
+	_, err = conn.ReadHoldingRegisters(1, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, conn.Close())
+
+	// physical connection is released with its last slave connection
+	mu.Lock()
+	_, ok := connections[sim.Addr()]
+	mu.Unlock()
+	require.False(t, ok)
+}
+
+func TestWriteReadRegisters(t *testing.T) {
+	for _, supported := range []bool{true, false} {
+		sim, err := simulator.NewRTUSimulator(map[uint16]uint16{1: 0, 2: 0x1234})
+		require.NoError(t, err)
+		defer sim.Close()
+
+		if !supported {
+			sim.DisableFunction(0x17)
+		}
+
+		conn, err := NewConnection(sim.Addr(), "", "", 0, Rtu, 1)
+		require.NoError(t, err)
+		conn.Timeout(100 * time.Millisecond)
+
+		b, err := conn.WriteReadRegisters(1, 1, []byte{0x00, 0x01}, 2)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x00, 0x01, 0x12, 0x34}, b)
+		require.Equal(t, uint16(1), sim.Register(1))
+
+		// fall back to separate operations
+		require.Equal(t, !supported, conn.noReadWrite.Load())
+	}
+}
+
+func TestWriteRateLimit(t *testing.T) {
+	sim, err := simulator.NewRTUSimulator(map[uint16]uint16{1: 0, 2: 0})
+	require.NoError(t, err)
+	defer sim.Close()
+
+	conn, err := NewConnection(sim.Addr(), "", "", 0, Rtu, 1)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.Timeout(100 * time.Millisecond)
+	conn.WriteRateLimit(200 * time.Millisecond)
+
+	start := time.Now()
+
+	// different registers are not delayed
+	_, err = conn.WriteSingleRegister(1, 1)
+	require.NoError(t, err)
+	_, err = conn.WriteSingleRegister(2, 1)
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), 200*time.Millisecond)
+
+	// same register is delayed
+	_, err = conn.WriteSingleRegister(1, 2)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+	require.Equal(t, uint16(2), sim.Register(1))
+
+	// pending write is abandoned on close
+	require.NoError(t, conn.Close())
+	_, err = conn.WriteSingleRegister(1, 3)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWakeup(t *testing.T) {
+	sim, err := simulator.NewRTUSimulator(map[uint16]uint16{1: 0x1234, 2: 0})
+	require.NoError(t, err)
+	defer sim.Close()
+
+	conn, err := NewConnection(sim.Addr(), "", "", 0, Rtu, 1)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.Timeout(100 * time.Millisecond)
+
+	var wakes int
+	conn.Wakeup(func() error {
+		wakes++
+		_, err := conn.WriteSingleRegister(2, 1)
+		return err
+	}, 1)
+
+	_, err = conn.ReadHoldingRegisters(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, 0, wakes)
+
+	// sleeping device ignores the operation and the wakeup, operation is retried nevertheless
+	sim.Ignore(2)
+	b, err := conn.ReadHoldingRegisters(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x12, 0x34}, b)
+	require.Equal(t, 1, wakes)
+
+	// wakeup attempts exhausted
+	sim.Ignore(3)
+	_, err = conn.ReadHoldingRegisters(1, 1)
+	require.Error(t, err)
+	require.False(t, conn.Healthy())
+	require.Equal(t, 2, wakes)
+
+	// unhealthy device is woken up before the next operation
+	_, err = conn.ReadHoldingRegisters(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, wakes)
+	require.True(t, conn.Healthy())
+}
@@ -0,0 +1,29 @@
+package modbus
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grid-x/modbus"
+)
+
+// ErrResponseLength is returned if the device response is shorter or longer than requested
+var ErrResponseLength = errors.New("invalid response length")
+
+// CheckRegisterResponse verifies the response length before decoding
+func CheckRegisterResponse(b []byte, expectedBytes int) error {
+	if len(b) != expectedBytes {
+		return fmt.Errorf("%w: %d bytes, expected %d", ErrResponseLength, len(b), expectedBytes)
+	}
+	return nil
+}
+
+// responseBytes returns the expected response length of a read operation
+func responseBytes(functionCode byte, quantity uint16) int {
+	switch functionCode {
+	case modbus.FuncCodeReadCoils, modbus.FuncCodeReadDiscreteInputs:
+		return (int(quantity) + 7) / 8
+	default:
+		return 2 * int(quantity)
+	}
+}
@@ -0,0 +1,18 @@
+package modbus
+
+import (
+	"testing"
+
+	"github.com/grid-x/modbus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRegisterResponse(t *testing.T) {
+	require.NoError(t, CheckRegisterResponse([]byte{0, 1, 0, 2}, 4))
+	require.ErrorIs(t, CheckRegisterResponse([]byte{0, 1}, 4), ErrResponseLength)
+	require.ErrorIs(t, CheckRegisterResponse(nil, 2), ErrResponseLength)
+
+	assert.Equal(t, 4, responseBytes(modbus.FuncCodeReadHoldingRegisters, 2))
+	assert.Equal(t, 2, responseBytes(modbus.FuncCodeReadCoils, 9))
+}
@@ -0,0 +1,51 @@
+package modbus
+
+import (
+	"testing"
+
+	"github.com/grid-x/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+// responseTransporter returns a fixed response frame for any request
+type responseTransporter []byte
+
+func (t responseTransporter) Send([]byte) ([]byte, error) {
+	return t, nil
+}
+
+func FuzzRTUResponseParse(f *testing.F) {
+	packager := modbus.NewRTUClientHandler("")
+
+	// valid response frames
+	for _, pdu := range []modbus.ProtocolDataUnit{
+		{FunctionCode: modbus.FuncCodeReadHoldingRegisters, Data: []byte{4, 0, 1, 0, 2}},
+		{FunctionCode: modbus.FuncCodeReadInputRegisters, Data: []byte{4, 0, 1, 0, 2}},
+		{FunctionCode: modbus.FuncCodeReadHoldingRegisters | 0x80, Data: []byte{modbus.ExceptionCodeIllegalDataAddress}},
+	} {
+		adu, err := packager.Encode(&pdu)
+		require.NoError(f, err)
+		f.Add(adu)
+	}
+
+	// truncated frames
+	f.Add([]byte{})
+	f.Add([]byte{0, 3})
+	f.Add([]byte{0, 3, 4, 0, 1})
+
+	f.Fuzz(func(t *testing.T, frame []byte) {
+		client := modbus.NewClient2(packager, responseTransporter(frame))
+
+		for _, read := range []func(address, quantity uint16) ([]byte, error){
+			client.ReadHoldingRegisters,
+			client.ReadInputRegisters,
+		} {
+			b, err := read(0, 2)
+			err = exceptionError(err, 0)
+
+			if err == nil && len(b) != 4 {
+				t.Errorf("invalid response length %d without error", len(b))
+			}
+		}
+	})
+}
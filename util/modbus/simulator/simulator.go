@@ -0,0 +1,262 @@
+// Package simulator provides a Modbus RTU device simulator for testing device implementations without hardware.
+package simulator
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+)
+
+const (
+	fcReadHoldingRegisters   = 0x03
+	fcReadInputRegisters     = 0x04
+	fcWriteSingleRegister    = 0x06
+	fcWriteMultipleRegisters = 0x10
+	fcReadWriteRegisters     = 0x17
+
+	exIllegalFunction    = 0x01
+	exIllegalDataAddress = 0x02
+)
+
+// RTUSimulator responds to Modbus RTU frames from a register map.
+// Holding and input registers share the same address space.
+type RTUSimulator struct {
+	mu        sync.Mutex
+	registers map[uint16]uint16
+	disabled  map[byte]bool
+	ignore    int
+	listener  net.Listener
+	conns     map[net.Conn]struct{}
+}
+
+// NewRTUSimulator creates a simulator serving RTU over TCP on a local port
+func NewRTUSimulator(registers map[uint16]uint16) (*RTUSimulator, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	if registers == nil {
+		registers = make(map[uint16]uint16)
+	}
+
+	s := &RTUSimulator{
+		registers: registers,
+		disabled:  make(map[byte]bool),
+		listener:  l,
+		conns:     make(map[net.Conn]struct{}),
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			s.mu.Lock()
+			s.conns[conn] = struct{}{}
+			s.mu.Unlock()
+
+			go func() {
+				defer func() {
+					s.mu.Lock()
+					delete(s.conns, conn)
+					s.mu.Unlock()
+					conn.Close()
+				}()
+
+				_ = s.Serve(conn)
+			}()
+		}
+	}()
+
+	return s, nil
+}
+
+// Addr returns the simulator's address
+func (s *RTUSimulator) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Close stops accepting connections and closes all client connections
+func (s *RTUSimulator) Close() error {
+	err := s.listener.Close()
+
+	s.mu.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+
+	return err
+}
+
+// Register returns the register value
+func (s *RTUSimulator) Register(addr uint16) uint16 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.registers[addr]
+}
+
+// SetRegister sets the register value
+func (s *RTUSimulator) SetRegister(addr, value uint16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.registers[addr] = value
+}
+
+// DisableFunction makes the simulator respond to the function code with an Illegal Function exception
+func (s *RTUSimulator) DisableFunction(fc byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.disabled[fc] = true
+}
+
+// Ignore makes the simulator leave the next count request frames unanswered, e.g. to simulate a sleeping device
+func (s *RTUSimulator) Ignore(count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ignore = count
+}
+
+// Serve handles request frames until the connection fails
+func (s *RTUSimulator) Serve(conn io.ReadWriter) error {
+	for {
+		req, err := readFrame(conn)
+		if err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		ignore := s.ignore > 0
+		if ignore {
+			s.ignore--
+		}
+		s.mu.Unlock()
+
+		if ignore {
+			continue
+		}
+
+		if _, err := conn.Write(s.handle(req)); err != nil {
+			return err
+		}
+	}
+}
+
+// readFrame reads a complete request frame including crc
+func readFrame(r io.Reader) ([]byte, error) {
+	b := make([]byte, 8)
+	if _, err := io.ReadFull(r, b[:2]); err != nil {
+		return nil, err
+	}
+
+	switch b[1] {
+	case fcReadHoldingRegisters, fcReadInputRegisters, fcWriteSingleRegister:
+		// address, quantity or value, crc
+	case fcWriteMultipleRegisters:
+		// address, quantity, byte count
+		if _, err := io.ReadFull(r, b[2:7]); err != nil {
+			return nil, err
+		}
+		b = append(b[:7], make([]byte, int(b[6])+2)...)
+		_, err := io.ReadFull(r, b[7:])
+		return b, err
+	case fcReadWriteRegisters:
+		// read address, read quantity, write address, write quantity, byte count
+		b = append(b, make([]byte, 3)...)
+		if _, err := io.ReadFull(r, b[2:11]); err != nil {
+			return nil, err
+		}
+		b = append(b[:11], make([]byte, int(b[10])+2)...)
+		_, err := io.ReadFull(r, b[11:])
+		return b, err
+	default:
+		return nil, errors.New("unsupported function code")
+	}
+
+	_, err := io.ReadFull(r, b[2:])
+	return b, err
+}
+
+// handle creates the response frame for the request
+func (s *RTUSimulator) handle(req []byte) []byte {
+	if crc(req[:len(req)-2]) != binary.LittleEndian.Uint16(req[len(req)-2:]) {
+		return frame(req[0], req[1]|0x80, exIllegalFunction)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.disabled[req[1]] {
+		return frame(req[0], req[1]|0x80, exIllegalFunction)
+	}
+
+	addr := binary.BigEndian.Uint16(req[2:])
+	val := binary.BigEndian.Uint16(req[4:])
+
+	switch req[1] {
+	case fcReadHoldingRegisters, fcReadInputRegisters:
+		res := []byte{byte(2 * val)}
+		for i := range val {
+			v, ok := s.registers[addr+i]
+			if !ok {
+				return frame(req[0], req[1]|0x80, exIllegalDataAddress)
+			}
+			res = binary.BigEndian.AppendUint16(res, v)
+		}
+		return frame(req[0], req[1], res...)
+
+	case fcWriteSingleRegister:
+		s.registers[addr] = val
+		return frame(req[0], req[1], req[2:6]...)
+
+	case fcReadWriteRegisters:
+		// write is performed before read
+		waddr := binary.BigEndian.Uint16(req[6:])
+		for i := range binary.BigEndian.Uint16(req[8:]) {
+			s.registers[waddr+i] = binary.BigEndian.Uint16(req[11+2*i:])
+		}
+
+		res := []byte{byte(2 * val)}
+		for i := range val {
+			v, ok := s.registers[addr+i]
+			if !ok {
+				return frame(req[0], req[1]|0x80, exIllegalDataAddress)
+			}
+			res = binary.BigEndian.AppendUint16(res, v)
+		}
+		return frame(req[0], req[1], res...)
+
+	default: // fcWriteMultipleRegisters
+		for i := range val {
+			s.registers[addr+i] = binary.BigEndian.Uint16(req[7+2*i:])
+		}
+		return frame(req[0], req[1], req[2:6]...)
+	}
+}
+
+// frame creates an RTU frame with crc
+func frame(slave, fc byte, data ...byte) []byte {
+	b := append([]byte{slave, fc}, data...)
+	return binary.LittleEndian.AppendUint16(b, crc(b))
+}
+
+// crc calculates the Modbus RTU crc16
+func crc(b []byte) uint16 {
+	res := uint16(0xFFFF)
+	for _, v := range b {
+		res ^= uint16(v)
+		for range 8 {
+			if res&1 != 0 {
+				res = res>>1 ^ 0xA001
+			} else {
+				res >>= 1
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,62 @@
+package modbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"golang.org/x/net/proxy"
+)
+
+const socksScheme = "socks5://"
+
+// NewSocksConnection creates a Modbus TCP connection tunneled through a SOCKS5 proxy.
+// The uri has the form socks5://[user:password@]proxy:port/device:port. The proxy must be reachable on creation.
+func NewSocksConnection(uri string, slaveID uint8) (*Connection, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy: %w", err)
+	}
+
+	target := strings.Trim(u.Path, "/")
+	if u.Host == "" || target == "" {
+		return nil, errors.New("invalid modbus configuration: need socks5://proxy/device uri")
+	}
+
+	address := util.DefaultPort(u.Host, 1080)
+	target = util.DefaultPort(target, 502)
+
+	var auth *proxy.Auth
+	if u.User != nil {
+		password, _ := u.User.Password()
+		auth = &proxy.Auth{User: u.User.Username(), Password: password}
+	}
+
+	// fail early instead of on first read
+	conn, err := net.DialTimeout("tcp", address, dialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("proxy unreachable: %w", err)
+	}
+	conn.Close()
+
+	dial := func(timeout time.Duration) (net.Conn, error) {
+		dialer, err := proxy.SOCKS5("tcp", address, auth, &net.Dialer{Timeout: timeout})
+		if err != nil {
+			return nil, err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		return dialer.(proxy.ContextDialer).DialContext(ctx, "tcp", target)
+	}
+
+	bus, connMu, release := registeredConnection(uri, newDialConnection(target, dial))
+
+	return newConnection(bus, connMu, release, slaveID), nil
+}
@@ -0,0 +1,131 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// socksServer implements a SOCKS5 proxy supporting username/password authentication and IPv4 connect
+func socksServer(t *testing.T, user, password string) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+
+				// greeting
+				b := make([]byte, 2)
+				if _, err := io.ReadFull(conn, b); err != nil {
+					return
+				}
+				if _, err := io.ReadFull(conn, make([]byte, b[1])); err != nil {
+					return
+				}
+				if _, err := conn.Write([]byte{5, 2}); err != nil {
+					return
+				}
+
+				// username/password
+				if _, err := io.ReadFull(conn, b); err != nil {
+					return
+				}
+				u := make([]byte, b[1])
+				if _, err := io.ReadFull(conn, u); err != nil {
+					return
+				}
+				if _, err := io.ReadFull(conn, b[:1]); err != nil {
+					return
+				}
+				p := make([]byte, b[0])
+				if _, err := io.ReadFull(conn, p); err != nil {
+					return
+				}
+
+				if string(u) != user || string(p) != password {
+					_, _ = conn.Write([]byte{1, 1})
+					return
+				}
+				if _, err := conn.Write([]byte{1, 0}); err != nil {
+					return
+				}
+
+				// connect request
+				req := make([]byte, 10)
+				if _, err := io.ReadFull(conn, req); err != nil {
+					return
+				}
+
+				target := net.JoinHostPort(net.IP(req[4:8]).String(), strconv.Itoa(int(binary.BigEndian.Uint16(req[8:]))))
+
+				upstream, err := net.Dial("tcp", target)
+				if err != nil {
+					return
+				}
+				defer upstream.Close()
+
+				if _, err := conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0}); err != nil {
+					return
+				}
+
+				go func() { _, _ = io.Copy(upstream, conn) }()
+				_, _ = io.Copy(conn, upstream)
+			}()
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+func TestSocksConnection(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	device := registerServer(t, l)
+	proxy := socksServer(t, "user", "pass")
+
+	conn, err := NewConnection(socksScheme+"user:pass@"+proxy+"/"+device, "", "", 0, Tcp, 1)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.Timeout(time.Second)
+
+	b, err := conn.ReadHoldingRegisters(0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x12, 0x34}, b)
+
+	// invalid credentials
+	conn, err = NewConnection(socksScheme+"user:wrong@"+proxy+"/"+device, "", "", 0, Tcp, 2)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.Timeout(time.Second)
+
+	_, err = conn.ReadHoldingRegisters(0, 1)
+	assert.Error(t, err)
+
+	// unreachable proxy
+	l, err = net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	unreachable := l.Addr().String()
+	l.Close()
+
+	_, err = NewConnection(socksScheme+unreachable+"/"+device, "", "", 0, Tcp, 1)
+	assert.ErrorContains(t, err, "proxy unreachable")
+
+	// missing device
+	_, err = NewConnection(socksScheme+proxy, "", "", 0, Tcp, 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,87 @@
+package modbus
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+const (
+	tcpScheme = "modbus://"
+	tlsScheme = "modbus+tls://"
+)
+
+// TLSSettings contains the Modbus TCP over TLS settings
+type TLSSettings struct {
+	Enabled            bool
+	Cert, Key, CA      string // pem files, cert and key for client authentication
+	InsecureSkipVerify bool
+}
+
+// Config creates the tls configuration. Server certificates are verified against the system roots unless a CA is given.
+func (s *TLSSettings) Config(host string) (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: s.InsecureSkipVerify,
+	}
+
+	if s.Cert != "" || s.Key != "" {
+		cert, err := tls.LoadX509KeyPair(s.Cert, s.Key)
+		if err != nil {
+			return nil, fmt.Errorf("client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	if s.CA != "" {
+		b, err := os.ReadFile(s.CA)
+		if err != nil {
+			return nil, fmt.Errorf("ca: %w", err)
+		}
+
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("ca: no certificates found in %s", s.CA)
+		}
+	}
+
+	return config, nil
+}
+
+// NewTLSConnection creates a Modbus TCP connection which uses TLS unless disabled or the uri has the plain modbus:// scheme
+func NewTLSConnection(uri string, slaveID uint8, settings TLSSettings) (*Connection, error) {
+	if strings.HasPrefix(uri, tcpScheme) || !settings.Enabled && !strings.HasPrefix(uri, tlsScheme) {
+		return NewConnection(strings.TrimPrefix(uri, tcpScheme), "", "", 0, Tcp, slaveID)
+	}
+
+	address := strings.TrimPrefix(uri, tlsScheme)
+	if address == "" {
+		return nil, errors.New("invalid modbus configuration: need uri")
+	}
+
+	address = util.DefaultPort(address, 802)
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := settings.Config(host)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, connMu, release := registeredConnection(tlsScheme+address, newDialConnection(address, func(timeout time.Duration) (net.Conn, error) {
+		dialer := &net.Dialer{Timeout: timeout}
+		return tls.DialWithDialer(dialer, "tcp", address, config)
+	}))
+
+	return newConnection(conn, connMu, release, slaveID), nil
+}
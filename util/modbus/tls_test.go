@@ -0,0 +1,109 @@
+package modbus
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tlsServer serves a single holding register value over Modbus TCP with TLS
+func tlsServer(t *testing.T, cert tls.Certificate) string {
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	require.NoError(t, err)
+	return registerServer(t, l)
+}
+
+// registerServer serves a single holding register value over Modbus TCP
+func registerServer(t *testing.T, l net.Listener) string {
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+
+				for {
+					req := make([]byte, 12)
+					if _, err := io.ReadFull(conn, req); err != nil {
+						return
+					}
+
+					res := append([]byte{}, req[:8]...)
+					binary.BigEndian.PutUint16(res[4:], 5)
+					res = append(res, 2, 0x12, 0x34)
+
+					if _, err := conn.Write(res); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+func TestTLSConnection(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	ca := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(ca, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+
+	cert := tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+
+	for _, tc := range []struct {
+		settings TLSSettings
+		ok       bool
+	}{
+		{TLSSettings{Enabled: true}, false},
+		{TLSSettings{Enabled: true, CA: ca}, true},
+		{TLSSettings{Enabled: true, InsecureSkipVerify: true}, true},
+	} {
+		conn, err := NewTLSConnection(tlsScheme+tlsServer(t, cert), 1, tc.settings)
+		require.NoError(t, err)
+		conn.Timeout(time.Second)
+
+		b, err := conn.ReadHoldingRegisters(0, 1)
+		if !tc.ok {
+			assert.Error(t, err, tc.settings)
+			continue
+		}
+
+		require.NoError(t, err, tc.settings)
+		assert.Equal(t, []byte{0x12, 0x34}, b)
+	}
+}
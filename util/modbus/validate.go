@@ -0,0 +1,26 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Validation contains a known-constant input register used to verify the connected device
+type Validation struct {
+	Address  uint16
+	Expected uint16
+}
+
+// Validate reads the validation register and compares it to the expected value
+func (v *Validation) Validate(conn *Connection) error {
+	b, err := conn.ReadInputRegisters(v.Address, 1)
+	if err != nil {
+		return fmt.Errorf("validate register %d: %w", v.Address, err)
+	}
+
+	if actual := binary.BigEndian.Uint16(b); actual != v.Expected {
+		return fmt.Errorf("validate register %d: expected %d, got %d (check slave id)", v.Address, v.Expected, actual)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package modbus
+
+import (
+	"errors"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+// Watchdog contains the heartbeat register configuration
+type Watchdog struct {
+	Register uint16
+	Interval time.Duration
+	Value    uint16
+}
+
+// Watchdog periodically writes the heartbeat value until the connection is closed
+func (mb *Connection) Watchdog(log *util.Logger, wd Watchdog) error {
+	if wd.Interval <= 0 {
+		return errors.New("watchdog: invalid interval")
+	}
+
+	go func() {
+		ticker := time.NewTicker(wd.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-mb.ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := mb.WriteSingleRegister(wd.Register, wd.Value); err != nil {
+					log.WARN.Printf("watchdog: %v", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -8,8 +8,12 @@ import (
 	"strings"
 )
 
-// DefaultPort appends given port to connection if not specified
+// DefaultPort appends given port to connection if not specified. URIs with scheme are returned unchanged.
 func DefaultPort(conn string, port int) string {
+	if strings.Contains(conn, "://") {
+		return conn
+	}
+
 	if _, _, err := net.SplitHostPort(conn); err != nil {
 		conn = net.JoinHostPort(conn, strconv.Itoa(port))
 	}
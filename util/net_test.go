@@ -15,6 +15,11 @@ func TestDefaultPort(t *testing.T) {
 	if uri := DefaultPort("foo", 7090); uri != expect {
 		t.Errorf("expected %s, got %s", expect, uri)
 	}
+
+	expect = "socks5://proxy:1080/foo:7090"
+	if uri := DefaultPort(expect, 7090); uri != expect {
+		t.Errorf("expected %s, got %s", expect, uri)
+	}
 }
 
 func TestDefaultScheme(t *testing.T) {
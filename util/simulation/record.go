@@ -0,0 +1,64 @@
+package simulation
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Record is a set of recorded values at a point in time
+type Record struct {
+	Time   time.Time
+	Values map[string]string
+}
+
+// ReadRecords reads timestamped values from CSV. The first column contains the RFC3339 timestamp,
+// the header names the keys of the remaining columns. Empty cells are skipped.
+func ReadRecords(r io.Reader) ([]Record, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	if len(header) < 2 || !strings.EqualFold(header[0], "time") {
+		return nil, errors.New("header: first column must be time followed by value keys")
+	}
+
+	var res []Record
+
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		ts, err := time.Parse(time.RFC3339, row[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", len(res)+2, err)
+		}
+
+		if len(res) > 0 && ts.Before(res[len(res)-1].Time) {
+			return nil, fmt.Errorf("line %d: time not ascending", len(res)+2)
+		}
+
+		rec := Record{Time: ts, Values: make(map[string]string)}
+		for i, val := range row[1:] {
+			if val != "" {
+				rec.Values[header[i+1]] = val
+			}
+		}
+
+		res = append(res, rec)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,27 @@
+package simulation
+
+import "time"
+
+// Replay stores the recorded values at their original timestamps, accelerated by speed.
+// The step function is called after storing each record's values. Replay returns false if stopped.
+func Replay(records []Record, speed float64, stopC <-chan struct{}, step func(Record)) bool {
+	for i, rec := range records {
+		if i > 0 {
+			wait := time.Duration(float64(rec.Time.Sub(records[i-1].Time)) / speed)
+
+			select {
+			case <-time.After(wait):
+			case <-stopC:
+				return false
+			}
+		}
+
+		for key, val := range rec.Values {
+			Set(key, val)
+		}
+
+		step(rec)
+	}
+
+	return true
+}
@@ -0,0 +1,53 @@
+package simulation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRecords(t *testing.T) {
+	records, err := ReadRecords(strings.NewReader(`time,grid.power,wallbox.status
+2024-06-01T12:00:00Z,-1500,B
+2024-06-01T12:00:30Z,2600,
+`))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, map[string]string{"grid.power": "-1500", "wallbox.status": "B"}, records[0].Values)
+	assert.Equal(t, map[string]string{"grid.power": "2600"}, records[1].Values)
+	assert.Equal(t, 30*time.Second, records[1].Time.Sub(records[0].Time))
+
+	_, err = ReadRecords(strings.NewReader("grid.power\n1\n"))
+	assert.Error(t, err)
+
+	_, err = ReadRecords(strings.NewReader("time,grid.power\n2024-06-01T12:00:30Z,1\n2024-06-01T12:00:00Z,2\n"))
+	assert.Error(t, err)
+}
+
+func TestReplay(t *testing.T) {
+	start := time.Now()
+
+	records := []Record{
+		{Time: start, Values: map[string]string{"pv.power": "1000"}},
+		{Time: start.Add(time.Minute), Values: map[string]string{"pv.power": "2000"}},
+	}
+
+	var res []string
+	ok := Replay(records, 600, nil, func(rec Record) {
+		val, _ := Get("pv.power")
+		res = append(res, val)
+	})
+
+	assert.True(t, ok)
+	assert.Equal(t, []string{"1000", "2000"}, res)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	stopC := make(chan struct{})
+	close(stopC)
+
+	assert.False(t, Replay(records, 1, stopC, func(Record) {}))
+}
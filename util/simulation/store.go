@@ -0,0 +1,23 @@
+package simulation
+
+import "sync"
+
+var (
+	mu     sync.RWMutex
+	values = make(map[string]string)
+)
+
+// Set stores a simulated value
+func Set(key, value string) {
+	mu.Lock()
+	defer mu.Unlock()
+	values[key] = value
+}
+
+// Get returns a simulated value
+func Get(key string) (string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	val, ok := values[key]
+	return val, ok
+}
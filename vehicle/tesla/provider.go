@@ -36,6 +36,22 @@ func (v *Provider) Soc() (float64, error) {
 	return float64(res.Response.ChargeState.UsableBatteryLevel), nil
 }
 
+var _ api.OnBoardChargerLimit = (*Provider)(nil)
+
+// OBCLimit implements the api.OnBoardChargerLimit interface
+func (v *Provider) OBCLimit() (float64, error) {
+	res, err := v.dataG()
+	if err != nil {
+		return 0, err
+	}
+
+	if limit := res.Response.ChargeState.ChargeCurrentRequestMax; limit > 0 {
+		return float64(limit), nil
+	}
+
+	return 0, api.ErrNotAvailable
+}
+
 var _ api.ChargeState = (*Provider)(nil)
 
 // Status implements the api.ChargeState interface